@@ -1,11 +1,37 @@
 package awx
 
+import "context"
+
 type ApplicationService interface {
 	List(params map[string]string) ([]*Application, *ResultsList[Application], error)
 	GetByID(id int, params map[string]string) (*Application, error)
 	Create(data map[string]interface{}, params map[string]string) (*Application, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Application, error)
 	Delete(id int) (*Application, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Application, *ResultsList[Application], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Application, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Application, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Application, error)
+	DeleteContext(ctx context.Context, id int) (*Application, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Application, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Application]
+	ListQuery(ctx context.Context, q *Query) ([]*Application, *ResultsList[Application], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Application, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Application]
+	CreateWith(ctx context.Context, req CreateRequest[Application], params map[string]string) (*Application, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Application], params map[string]string) (*Application, error)
+	GetByName(name string, scope map[string]string) (*Application, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Application, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Application, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Application, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Application]) (*EnsureResult[Application], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Application, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type applicationServiceHTTP struct {
@@ -19,3 +45,53 @@ type ListApplicationResponse struct {
 }
 
 const applicationsAPIEndpoint = "/api/v2/applications/"
+
+// ApplicationCreateRequest holds the fields of a new awx application, its client type and
+// authorization grant type are checked against the values awx accepts.
+type ApplicationCreateRequest struct {
+	Name                   string `json:"name"`
+	Description            string `json:"description,omitempty"`
+	Organization           int    `json:"organization"`
+	ClientType             string `json:"client_type"`
+	AuthorizationGrantType string `json:"authorization_grant_type"`
+	RedirectURIs           string `json:"redirect_uris,omitempty"`
+	SkipAuthorization      *bool  `json:"skip_authorization,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *ApplicationCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("organization", r.Organization != 0),
+		required("client_type", r.ClientType != ""),
+		required("authorization_grant_type", r.AuthorizationGrantType != ""),
+		oneOf("client_type", r.ClientType, "confidential", "public"),
+		oneOf("authorization_grant_type", r.AuthorizationGrantType, "authorization-code", "password"),
+	)
+}
+
+func (*ApplicationCreateRequest) createRequest(*Application) {}
+
+// ApplicationUpdateRequest changes some fields of an application, the nil ones are left as they are.
+type ApplicationUpdateRequest struct {
+	Name                   *string `json:"name,omitempty"`
+	Description            *string `json:"description,omitempty"`
+	Organization           *int    `json:"organization,omitempty"`
+	ClientType             *string `json:"client_type,omitempty"`
+	AuthorizationGrantType *string `json:"authorization_grant_type,omitempty"`
+	RedirectURIs           *string `json:"redirect_uris,omitempty"`
+	SkipAuthorization      *bool   `json:"skip_authorization,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *ApplicationUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		notEmpty("client_type", r.ClientType),
+		notEmpty("authorization_grant_type", r.AuthorizationGrantType),
+		oneOf("client_type", deref(r.ClientType), "confidential", "public"),
+		oneOf("authorization_grant_type", deref(r.AuthorizationGrantType), "authorization-code", "password"),
+	)
+}
+
+func (*ApplicationUpdateRequest) updateRequest(*Application) {}
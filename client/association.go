@@ -0,0 +1,148 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Parent is implemented by the objects whose related objects are managed by an Association,
+// it gives the endpoint of the parent objects.
+type Parent interface {
+	JobTemplate | WorkflowJobTemplate | Inventory | Group | Host | Organization | Team | User
+	apiEndpoint() string
+}
+
+func (JobTemplate) apiEndpoint() string         { return jobTemplatesAPIEndpoint }
+func (WorkflowJobTemplate) apiEndpoint() string { return workflowJobTemplateAPIEndpoint }
+func (Inventory) apiEndpoint() string           { return inventoriesAPIEndpoint }
+func (Group) apiEndpoint() string               { return groupsAPIEndpoint }
+func (Host) apiEndpoint() string                { return hostsAPIEndpoint }
+func (Organization) apiEndpoint() string        { return organizationsAPIEndpoint }
+func (Team) apiEndpoint() string                { return teamsAPIEndpoint }
+func (User) apiEndpoint() string                { return usersAPIEndpoint }
+
+// Association manages the objects of type C related to the objects of type P through a
+// `/{parent}/{id}/{relation}/` endpoint, e.g. the credentials of the job templates:
+//
+//	credentials := awx.NewAssociation[awx.JobTemplate, awx.Credential](client.Client(), "credentials")
+//	err := credentials.Associate(ctx, jobTemplateID, credentialID)
+type Association[P Parent, C any] struct {
+	client         *Client
+	parentEndpoint string
+	relation       string
+}
+
+// objectID decodes only the id of an object.
+type objectID struct {
+	ID int `json:"id"`
+}
+
+// NewAssociation creates an Association for the relation of the objects of type P, the services
+// also expose the common relations, such as JobTemplateService.CredentialsAssociation.
+func NewAssociation[P Parent, C any](client *Client, relation string) *Association[P, C] {
+	var parent P
+	return &Association[P, C]{
+		client:         client,
+		parentEndpoint: parent.apiEndpoint(),
+		relation:       relation,
+	}
+}
+
+// endpoint returns the relation endpoint of the parent with the given id.
+func (a *Association[P, C]) endpoint(parentID int) string {
+	return fmt.Sprintf("%s%d/%s/", a.parentEndpoint, parentID, a.relation)
+}
+
+// List returns every object related to the parent, fetching all the pages.
+func (a *Association[P, C]) List(ctx context.Context, parentID int, params map[string]string) ([]*C, error) {
+	return ListAll[C](ctx, a.client, a.endpoint(parentID), params)
+}
+
+// Associate relates the existing child to the parent.
+func (a *Association[P, C]) Associate(ctx context.Context, parentID, childID int) error {
+	return a.send(ctx, parentID, map[string]interface{}{"id": childID}, nil)
+}
+
+// Disassociate removes the relation between the child and the parent, the child is not deleted.
+func (a *Association[P, C]) Disassociate(ctx context.Context, parentID, childID int) error {
+	return a.send(ctx, parentID, map[string]interface{}{"id": childID, "disassociate": true}, nil)
+}
+
+// Create creates a child from data and relates it to the parent.
+func (a *Association[P, C]) Create(ctx context.Context, parentID int, data map[string]interface{}) (*C, error) {
+	if _, ok := data["id"]; ok {
+		return nil, fmt.Errorf("%w: a created object has no id, use Associate for existing objects", ErrValidation)
+	}
+
+	result := new(C)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.client.Requester.PostJSONContext(ctx, a.endpoint(parentID), bytes.NewReader(payload), result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Set makes childIDs the only children of the parent: the missing children are associated
+// and the others disassociated. The children are disassociated first, as some relations
+// do not allow two children of the same kind, e.g. two machine credentials of a job template.
+func (a *Association[P, C]) Set(ctx context.Context, parentID int, childIDs []int) error {
+	current, err := ListAll[objectID](ctx, a.client, a.endpoint(parentID), nil)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[int]bool, len(childIDs))
+	for _, id := range childIDs {
+		wanted[id] = true
+	}
+
+	associated := make(map[int]bool, len(current))
+	for _, child := range current {
+		associated[child.ID] = true
+		if !wanted[child.ID] {
+			if err := a.Disassociate(ctx, parentID, child.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, id := range childIDs {
+		if !associated[id] {
+			if err := a.Associate(ctx, parentID, id); err != nil {
+				return err
+			}
+			associated[id] = true
+		}
+	}
+	return nil
+}
+
+// send posts an association payload holding the child id, the response is decoded into result.
+func (a *Association[P, C]) send(ctx context.Context, parentID int, data map[string]interface{}, result interface{}) error {
+	validate, status := ValidateParams(data, []string{"id"})
+	if !status {
+		return newMandatoryFieldsError(validate)
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Requester.PostJSONContext(ctx, a.endpoint(parentID), bytes.NewReader(payload), result, nil)
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
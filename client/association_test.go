@@ -0,0 +1,115 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// newAssociationServer fakes the credentials of the job template 1, it records the association payloads.
+func newAssociationServer(t *testing.T, credentials map[int]bool) (*testServer, *[]string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var posts []string
+	server := newTestServer(t, testRoutes{
+		"GET /api/v2/job_templates/1/credentials/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			results := []map[string]int{}
+			for id := range credentials {
+				results = append(results, map[string]int{"id": id})
+			}
+			writeJSON(w, map[string]interface{}{"count": len(results), "results": results})
+		},
+		"POST /api/v2/job_templates/1/credentials/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			data := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&data)
+			id := int(data["id"].(float64))
+			if data["disassociate"] == true {
+				delete(credentials, id)
+				posts = append(posts, fmt.Sprintf("-%d", id))
+			} else {
+				credentials[id] = true
+				posts = append(posts, fmt.Sprintf("+%d", id))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+
+	return server, &posts
+}
+
+func TestAssociationSet(t *testing.T) {
+	credentials := map[int]bool{1: true, 2: true}
+	server, posts := newAssociationServer(t, credentials)
+
+	association := (&jobTemplateServiceHTTP{client: server.awxClient()}).CredentialsAssociation()
+	if err := association.Set(context.Background(), 1, []int{2, 3}); err != nil {
+		t.Fatalf("Set err: %s", err)
+	}
+
+	if !reflect.DeepEqual(*posts, []string{"-1", "+3"}) {
+		t.Fatalf("Expecting -1 then +3 but got %v", *posts)
+	}
+
+	listed, err := association.List(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatalf("List err: %s", err)
+	}
+	ids := []int{}
+	for _, credential := range listed {
+		ids = append(ids, credential.ID)
+	}
+	sort.Ints(ids)
+	if !reflect.DeepEqual(ids, []int{2, 3}) {
+		t.Fatalf("Expecting credentials [2 3] but got %v", ids)
+	}
+}
+
+func TestAssociateCredentialsUsesAssociation(t *testing.T) {
+	server, posts := newAssociationServer(t, map[int]bool{})
+
+	client := server.awxClient()
+	service := &jobTemplateServiceHTTP{client: client}
+
+	if _, err := service.AssociateCredentials(1, map[string]interface{}{"id": 4}, nil); err != nil {
+		t.Fatalf("AssociateCredentials err: %s", err)
+	}
+	if _, err := service.DisAssociateCredentials(1, map[string]interface{}{"id": 4}, nil); err != nil {
+		t.Fatalf("DisAssociateCredentials err: %s", err)
+	}
+	if _, err := service.AssociateCredentials(1, map[string]interface{}{}, nil); err == nil {
+		t.Fatalf("Expecting an error without an id")
+	}
+
+	if !reflect.DeepEqual(*posts, []string{"+4", "-4"}) {
+		t.Fatalf("Expecting +4 then -4 but got %v", *posts)
+	}
+}
+
+func TestAssociationParentEndpoint(t *testing.T) {
+	testTable := []struct {
+		endpoint string
+		want     string
+	}{
+		{endpoint: NewAssociation[JobTemplate, Credential](nil, "credentials").endpoint(1), want: "/api/v2/job_templates/1/credentials/"},
+		{endpoint: NewAssociation[Inventory, InstanceGroup](nil, "instance_groups").endpoint(2), want: "/api/v2/inventories/2/instance_groups/"},
+		{endpoint: (&teamServiceHTTP{}).UsersAssociation().endpoint(3), want: "/api/v2/teams/3/users/"},
+	}
+
+	for _, test := range testTable {
+		if test.endpoint != test.want {
+			t.Fatalf("Expecting %s but got %s", test.want, test.endpoint)
+		}
+	}
+}
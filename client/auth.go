@@ -0,0 +1,199 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator adds the awx credentials to a request.
+// Implement it to plug a custom authentication, e.g. a token fetched from a secrets agent.
+type Authenticator interface {
+	Authenticate(ctx context.Context, r *http.Request) error
+}
+
+// Reauthenticator is implemented by authenticators able to renew their credentials.
+// When awx answers 401, Reauthenticate is called and the request is sent once more.
+type Reauthenticator interface {
+	Reauthenticate(ctx context.Context) error
+}
+
+// AuthenticatorFunc is an adapter to use an ordinary function as an Authenticator.
+type AuthenticatorFunc func(ctx context.Context, r *http.Request) error
+
+// Authenticate calls f(ctx, r).
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, r *http.Request) error {
+	return f(ctx, r)
+}
+
+// BasicAuth represents http basic auth.
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Authenticate sets the basic auth header.
+func (ba *BasicAuth) Authenticate(_ context.Context, r *http.Request) error {
+	r.SetBasicAuth(ba.Username, ba.Password)
+	return nil
+}
+
+// LogValue implements slog.LogValuer, the password is redacted.
+func (ba *BasicAuth) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("username", ba.Username), slog.String("password", redacted))
+}
+
+// TokenAuth represents token authentication
+type TokenAuth struct {
+	Token string
+}
+
+// Authenticate sets the token as a bearer token.
+func (ta *TokenAuth) Authenticate(_ context.Context, r *http.Request) error {
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ta.Token))
+	return nil
+}
+
+// LogValue implements slog.LogValuer, the token is redacted.
+func (ta *TokenAuth) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("token", redacted))
+}
+
+// ChainAuth returns an Authenticator applying each of authenticators in order,
+// e.g. to authenticate against a proxy and against awx.
+// Reauthenticate and Close are forwarded to the authenticators implementing them.
+func ChainAuth(authenticators ...Authenticator) Authenticator {
+	return chainAuth(authenticators)
+}
+
+type chainAuth []Authenticator
+
+func (c chainAuth) Authenticate(ctx context.Context, r *http.Request) error {
+	for _, authenticator := range c {
+		if err := authenticator.Authenticate(ctx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c chainAuth) Reauthenticate(ctx context.Context) error {
+	for _, authenticator := range c {
+		if reauthenticator, ok := authenticator.(Reauthenticator); ok {
+			if err := reauthenticator.Reauthenticate(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c chainAuth) updateSession(resp *http.Response) {
+	for _, authenticator := range c {
+		if session, ok := authenticator.(sessionUpdater); ok {
+			session.updateSession(resp)
+		}
+	}
+}
+
+func (c chainAuth) Close() error {
+	var errs []error
+	for _, authenticator := range c {
+		if closer, ok := authenticator.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FileTokenAuth authenticates with a bearer token read from a file, e.g. a token
+// rotated by a secrets agent. The file is read again whenever it changes.
+type FileTokenAuth struct {
+	Path string
+	// CheckInterval is the minimum delay between two checks of the file, every request checks it when zero.
+	CheckInterval time.Duration
+
+	mu        sync.Mutex
+	token     string
+	modTime   time.Time
+	size      int64
+	lastCheck time.Time
+}
+
+// NewFileTokenAuth creates a FileTokenAuth reading the token from path.
+func NewFileTokenAuth(path string) *FileTokenAuth {
+	return &FileTokenAuth{Path: path}
+}
+
+// Authenticate sets the token of the file as a bearer token.
+func (fa *FileTokenAuth) Authenticate(_ context.Context, r *http.Request) error {
+	token, err := fa.Token()
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+// Reauthenticate reads the file again, the token may have been rotated
+// without changing the file modification time.
+func (fa *FileTokenAuth) Reauthenticate(_ context.Context) error {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+
+	return fa.load()
+}
+
+// Token returns the current token, reloading the file if it changed.
+func (fa *FileTokenAuth) Token() (string, error) {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+
+	if fa.token != "" && time.Since(fa.lastCheck) < fa.CheckInterval {
+		return fa.token, nil
+	}
+	fa.lastCheck = time.Now()
+
+	info, err := os.Stat(fa.Path)
+	if err != nil {
+		return "", err
+	}
+
+	if fa.token == "" || !info.ModTime().Equal(fa.modTime) || info.Size() != fa.size {
+		if err := fa.load(); err != nil {
+			return "", err
+		}
+	}
+	return fa.token, nil
+}
+
+// load reads the token file, the caller must hold the lock.
+func (fa *FileTokenAuth) load() error {
+	info, err := os.Stat(fa.Path)
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(fa.Path)
+	if err != nil {
+		return err
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return fmt.Errorf("awx: token file %s is empty", fa.Path)
+	}
+
+	fa.token = token
+	fa.modTime = info.ModTime()
+	fa.size = info.Size()
+	return nil
+}
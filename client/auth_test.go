@@ -0,0 +1,77 @@
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileTokenAuthReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	auth := NewFileTokenAuth(path)
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/ping/", nil)
+	if err := auth.Authenticate(context.Background(), req); err != nil {
+		t.Fatalf("Authenticate err: %s", err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer first" {
+		t.Fatalf("Expecting Bearer first but got %s", got)
+	}
+
+	if err := os.WriteFile(path, []byte("second-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Second)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := auth.Authenticate(context.Background(), req); err != nil {
+		t.Fatalf("Authenticate err: %s", err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer second-token" {
+		t.Fatalf("Expecting Bearer second-token but got %s", got)
+	}
+}
+
+func TestChainAuthReauthenticate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("stale"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Proxy") != "proxy" || r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}})
+
+	proxy := AuthenticatorFunc(func(_ context.Context, r *http.Request) error {
+		r.Header.Set("X-Proxy", "proxy")
+		return nil
+	})
+	fileAuth := NewFileTokenAuth(path)
+	// the modification time is only checked once, the token is rotated in place
+	fileAuth.CheckInterval = time.Hour
+
+	requester := &Requester{Base: server.URL, Authenticator: ChainAuth(proxy, fileAuth), Client: server.Client()}
+	if _, err := requester.GetJSON("/api/v2/ping/", new(map[string]interface{}), nil); err == nil {
+		t.Fatalf("Expecting an error with the stale token")
+	}
+
+	if err := os.WriteFile(path, []byte("fresh"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := requester.GetJSON("/api/v2/ping/", new(map[string]interface{}), nil); err != nil {
+		t.Fatalf("Expecting the token to be reloaded on 401 but got %s", err)
+	}
+}
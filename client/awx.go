@@ -1,7 +1,7 @@
 package awx
 
 import (
-	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -27,14 +27,18 @@ type AWX struct {
 	ProjectService                                  ProjectService
 	ProjectUpdatesService                           ProjectUpdateService
 	TeamService                                     TeamService
+	TokenService                                    TokenService
 	ScheduleService                                 ScheduleService
 	SettingService                                  SettingService
 	UserService                                     UserService
+	WorkflowJobService                              WorkflowJobService
 	WorkflowJobTemplateService                      WorkflowJobTemplateService
 	WorkflowJobTemplateNodeService                  WorkflowJobTemplateNodeService
 	WorkflowJobTemplateNodeStepService              WorkflowJobTemplateNodeStepService
 	WorkflowJobTemplateScheduleService              WorkflowJobTemplateScheduleService
 	WorkflowJobTemplateNotificationTemplatesService WorkflowJobTemplateNotificationTemplateService
+
+	client *Client
 }
 
 // Client implement http client.
@@ -43,13 +47,13 @@ type Client struct {
 	Requester *Requester
 }
 
-// CheckResponse do http response check, and return err if not in [200, 300).
+// CheckResponse do http response check, and return an *APIError if not in [200, 300).
 func CheckResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
 	}
 
-	return fmt.Errorf("responsed with %d, resp: %v", resp.StatusCode, resp)
+	return newAPIError(resp)
 }
 
 // ValidateParams is to validate the input to use the services.
@@ -66,44 +70,68 @@ func ValidateParams(data map[string]interface{}, mandatoryFields []string) (notf
 	return notfound, status
 }
 
-// NewAWX news an awx handler with basic auth support, you could customize the http
-// transport by passing custom client.
-func NewAWX(baseURL, userName, passwd string, client *http.Client) (*AWX, error) {
-	r := &Requester{Base: baseURL, Authenticator: &BasicAuth{Username: userName, Password: passwd}, Client: client} // pragma: allowlist secret
-	if r.Client == nil {
-		r.Client = http.DefaultClient
-	}
-
-	awxClient := &Client{
-		BaseURL:   baseURL,
-		Requester: r,
+// New creates an awx handler for the awx server at baseURL, configured by opts.
+// Unless WithSkipPing is given, the connection is tested by pinging the server.
+func New(baseURL string, opts ...Option) (*AWX, error) {
+	o := &options{userAgent: DefaultUserAgent}
+	for _, opt := range opts {
+		opt(o)
 	}
 
-	newAWX := newAWX(awxClient)
-
-	// test the connection and return and error if there's an issue
-	_, err := newAWX.PingService.Ping()
+	client, err := o.buildHTTPClient()
 	if err != nil {
 		return nil, err
 	}
 
-	return newAWX, nil
+	r := &Requester{
+		Base:               baseURL,
+		Authenticator:      o.authenticator,
+		Client:             client,
+		RetryPolicy:        o.retryPolicy,
+		RateLimiter:        o.rateLimiter,
+		ConcurrencyLimiter: o.concurrencyLimiter,
+		UserAgent:          o.userAgent,
+		Headers:            o.headers,
+		Logger:             o.logger,
+		Middlewares:        o.middlewares,
+	}
+
+	return newAWXWithRequester(r, !o.skipPing)
 }
 
-// NewAWXToken creates an AWX handler with token support.
+// NewAWX news an awx handler with basic auth support, you could customize the http
+// transport by passing custom client. Unlike New, it sends the User-Agent of the http client.
+func NewAWX(baseURL, userName, passwd string, client *http.Client) (*AWX, error) {
+	return New(baseURL, WithBasicAuth(userName, passwd), WithHTTPClient(client), WithUserAgent(""))
+}
+
+// NewAWXToken creates an AWX handler with token support. Unlike New, it sends the User-Agent
+// of the http client.
 func NewAWXToken(baseURL, token string, client *http.Client) (*AWX, error) {
-	r := &Requester{Base: baseURL, Authenticator: &TokenAuth{Token: token}, Client: client}
+	return New(baseURL, WithToken(token), WithHTTPClient(client), WithUserAgent(""))
+}
+
+// NewAWXWithRequester creates an AWX handler on top of the given requester.
+// All the services share the requester, and thus its retry policy and limiters.
+func NewAWXWithRequester(r *Requester) (*AWX, error) {
+	return newAWXWithRequester(r, true)
+}
+
+func newAWXWithRequester(r *Requester, ping bool) (*AWX, error) {
 	if r.Client == nil {
 		r.Client = http.DefaultClient
 	}
 
 	awxClient := &Client{
-		BaseURL:   baseURL,
+		BaseURL:   r.Base,
 		Requester: r,
 	}
 
 	newAWX := newAWX(awxClient)
-	//newAWX.TeamService.List(xxxx)
+	if !ping {
+		return newAWX, nil
+	}
+
 	// test the connection and return and error if there's an issue
 	_, err := newAWX.PingService.Ping()
 	if err != nil {
@@ -113,97 +141,123 @@ func NewAWXToken(baseURL, token string, client *http.Client) (*AWX, error) {
 	return newAWX, nil
 }
 
+// Client returns the client shared by the services, e.g. to page through
+// a sub-list endpoint with NewPager.
+func (a *AWX) Client() *Client {
+	return a.client
+}
+
+// Close releases the resources held by the authenticator, e.g. revokes
+// the OAuth2 token obtained by an OAuth2Auth. Closing a nil or zero AWX does nothing.
+func (a *AWX) Close() error {
+	if a == nil || a.client == nil || a.client.Requester == nil {
+		return nil
+	}
+	if closer, ok := a.client.Requester.Authenticator.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 func newAWX(c *Client) *AWX {
 	return &AWX{
+		client: c,
 		ApplicationService: &applicationServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Application](c, applicationsAPIEndpoint, []string{"name", "client_type", "authorization_grant_type", "organization"}),
+			AWXResourceService: NewAWXResourceService[Application](c, applicationsAPIEndpoint, []string{"name", "client_type", "authorization_grant_type", "organization"}).withNaturalKey("name", "organization"),
 			client:             c,
 		},
 		CredentialService: &credentialServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Credential](c, credentialsAPIEndpoint, []string{}),
+			AWXResourceService: NewAWXResourceService[Credential](c, credentialsAPIEndpoint, []string{}).withNaturalKey("name", "organization", "credential_type").withNullableKey("organization"),
 			client:             c,
 		},
 		CredentialInputSourceService: &credentialInputSourceServiceHTTP{
-			AWXResourceService: NewAWXResourceService[CredentialInputSource](c, credentialInputSourceAPIEndpoint, []string{}),
+			AWXResourceService: NewAWXResourceService[CredentialInputSource](c, credentialInputSourceAPIEndpoint, []string{}).withNaturalKey("target_credential", "input_field_name"),
 			client:             c,
 		},
 		CredentialTypeService: &credentialTypeServiceHTTP{
-			AWXResourceService: NewAWXResourceService[CredentialType](c, credentialTypesAPIEndpoint, []string{}),
+			AWXResourceService: NewAWXResourceService[CredentialType](c, credentialTypesAPIEndpoint, []string{}).withNaturalKey("name", "kind"),
 			client:             c,
 		},
 		ExecutionEnvironmentService: &executionEnvironmentServiceHTTP{
-			AWXResourceService: NewAWXResourceService[ExecutionEnvironment](c, executionEnvironmentsAPIEndpoint, []string{"name", "inventory"}),
+			AWXResourceService: NewAWXResourceService[ExecutionEnvironment](c, executionEnvironmentsAPIEndpoint, []string{"name", "inventory"}).withNaturalKey("name", "organization").withNullableKey("organization"),
 			client:             c,
 		},
 		GroupService: &groupServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Group](c, groupsAPIEndpoint, []string{"name", "image"}),
+			AWXResourceService: NewAWXResourceService[Group](c, groupsAPIEndpoint, []string{"name", "image"}).withNaturalKey("name", "inventory"),
 			client:             c,
 		},
 		HostService: &hostServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Host](c, hostsAPIEndpoint, []string{"name", "inventory"}),
+			AWXResourceService: NewAWXResourceService[Host](c, hostsAPIEndpoint, []string{"name", "inventory"}).withNaturalKey("name", "inventory"),
 			client:             c,
 		},
 		InstanceGroupService: &instanceGroupServiceHTTP{
-			AWXResourceService: NewAWXResourceService[InstanceGroup](c, InstanceGroupsAPIEndpoint, []string{"name"}),
+			AWXResourceService: NewAWXResourceService[InstanceGroup](c, InstanceGroupsAPIEndpoint, []string{"name"}).withNaturalKey("name"),
 			client:             c,
 		},
 		InventoryService: &inventoryServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Inventory](c, inventoriesAPIEndpoint, []string{"name", "organization"}),
+			AWXResourceService: NewAWXResourceService[Inventory](c, inventoriesAPIEndpoint, []string{"name", "organization"}).withNaturalKey("name", "organization"),
 			client:             c,
 		},
 		InventorySourceService: &inventorySourceServiceHTTP{
-			AWXResourceService: NewAWXResourceService[InventorySource](c, inventorySourcesAPIEndpoint, []string{"name", "inventory"}),
+			AWXResourceService: NewAWXResourceService[InventorySource](c, inventorySourcesAPIEndpoint, []string{"name", "inventory"}).withNaturalKey("name", "inventory"),
 			client:             c,
 		},
 		JobService: &jobServiceHTTP{
 			client: c,
 		},
 		JobTemplateService: &jobTemplateServiceHTTP{
-			AWXResourceService: NewAWXResourceService[JobTemplate](c, jobTemplatesAPIEndpoint, []string{"name", "job_type", "inventory", "project"}),
+			AWXResourceService: NewAWXResourceService[JobTemplate](c, jobTemplatesAPIEndpoint, []string{"name", "job_type", "inventory", "project"}).withNaturalKey("name", "organization").withNullableKey("organization"),
 			client:             c,
 		},
 		JobTemplateNotificationTemplatesService: &jobTemplateNotificationTemplateServiceHTTP{
 			client: c,
 		},
 		NotificationTemplatesService: &notificationTemplateServiceHTTP{
-			AWXResourceService: NewAWXResourceService[NotificationTemplate](c, notificationTemplatesAPIEndpoint, []string{"name", "organization", "notification_type"}),
+			AWXResourceService: NewAWXResourceService[NotificationTemplate](c, notificationTemplatesAPIEndpoint, []string{"name", "organization", "notification_type"}).withNaturalKey("name", "organization"),
 			client:             c,
 		},
 		OrganizationService: &organizationServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Organization](c, organizationsAPIEndpoint, []string{"name"}),
+			AWXResourceService: NewAWXResourceService[Organization](c, organizationsAPIEndpoint, []string{"name"}).withNaturalKey("name"),
 			client:             c,
 		},
 		PingService: &pingServiceHTTP{
 			client: c,
 		},
 		ProjectService: &projectServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Project](c, projectsAPIEndpoint, []string{"name", "organization", "scm_type"}),
+			AWXResourceService: NewAWXResourceService[Project](c, projectsAPIEndpoint, []string{"name", "organization", "scm_type"}).withNaturalKey("name", "organization"),
 			client:             c,
 		},
 		ProjectUpdatesService: &projectUpdateServiceHTTP{
 			client: c,
 		},
 		TeamService: &teamServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Team](c, teamsAPIEndpoint, []string{"name", "organization"}),
+			AWXResourceService: NewAWXResourceService[Team](c, teamsAPIEndpoint, []string{"name", "organization"}).withNaturalKey("name", "organization"),
+			client:             c,
+		},
+		TokenService: &tokenServiceHTTP{
+			AWXResourceService: NewAWXResourceService[OAuth2Token](c, tokensAPIEndpoint, []string{}),
 			client:             c,
 		},
 		ScheduleService: &scheduleServiceHTTP{
-			AWXResourceService: NewAWXResourceService[Schedule](c, schedulesAPIEndpoint, []string{"name", "rrule", "unified_job_template"}),
+			AWXResourceService: NewAWXResourceService[Schedule](c, schedulesAPIEndpoint, []string{"name", "rrule", "unified_job_template"}).withNaturalKey("name", "unified_job_template"),
 			client:             c,
 		},
 		SettingService: &settingServiceHTTP{
 			client: c,
 		},
 		UserService: &userServiceHTTP{
-			AWXResourceService: NewAWXResourceService[User](c, usersAPIEndpoint, []string{"username", "password", "first_name", "last_name", "email"}),
+			AWXResourceService: NewAWXResourceService[User](c, usersAPIEndpoint, []string{"username", "password", "first_name", "last_name", "email"}).withNameField("username").withNaturalKey("username"),
 			client:             c,
 		},
+		WorkflowJobService: &workflowJobServiceHTTP{
+			client: c,
+		},
 		WorkflowJobTemplateService: &workflowJobTemplateServiceHTTP{
-			AWXResourceService: NewAWXResourceService[WorkflowJobTemplate](c, workflowJobTemplateAPIEndpoint, []string{"name"}),
+			AWXResourceService: NewAWXResourceService[WorkflowJobTemplate](c, workflowJobTemplateAPIEndpoint, []string{"name"}).withNaturalKey("name", "organization").withNullableKey("organization"),
 			client:             c,
 		},
 		WorkflowJobTemplateNodeService: &workflowJobTemplateNodeServiceHTTP{
-			AWXResourceService: NewAWXResourceService[WorkflowJobTemplateNode](c, workflowJobTemplateNodeAPIEndpoint, []string{"workflow_job_template", "unified_job_template", "identifier"}),
+			AWXResourceService: NewAWXResourceService[WorkflowJobTemplateNode](c, workflowJobTemplateNodeAPIEndpoint, []string{"workflow_job_template", "unified_job_template", "identifier"}).withNaturalKey("workflow_job_template", "identifier"),
 			client:             c,
 		},
 		WorkflowJobTemplateNodeStepService: &workflowJobTemplateNodeStepServiceHTTP{
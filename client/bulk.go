@@ -0,0 +1,237 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultBulkConcurrency is the number of requests a bulk operation sends at the same time by default.
+const DefaultBulkConcurrency = 4
+
+// BulkOption configures GetMany, DeleteMany and DeleteWhere.
+type BulkOption func(*bulkOptions)
+
+type bulkOptions struct {
+	concurrency int
+	dryRun      bool
+}
+
+// BulkConcurrency sends up to concurrency requests at the same time instead of DefaultBulkConcurrency.
+// The ConcurrencyLimiter of the requester still applies.
+func BulkConcurrency(concurrency int) BulkOption {
+	return func(o *bulkOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// BulkDryRun only reports the objects a bulk delete would delete, nothing is deleted.
+func BulkDryRun() BulkOption {
+	return func(o *bulkOptions) {
+		o.dryRun = true
+	}
+}
+
+func newBulkOptions(opts []BulkOption) *bulkOptions {
+	o := &bulkOptions{concurrency: DefaultBulkConcurrency}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.concurrency < 1 {
+		o.concurrency = 1
+	}
+	return o
+}
+
+// BulkError is returned by a bulk operation when some of its objects failed, the other ones succeeded.
+type BulkError struct {
+	// Errors maps the id of each failed object to its error.
+	Errors map[int]error
+}
+
+// IDs returns the sorted ids of the failed objects.
+func (e *BulkError) IDs() []int {
+	ids := make([]int, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// Error implements the error interface.
+func (e *BulkError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, id := range e.IDs() {
+		messages = append(messages, fmt.Sprintf("%d: %s", id, e.Errors[id]))
+	}
+	return fmt.Sprintf("awx: %d objects failed: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors of the failed objects, for errors.Is and errors.As.
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, id := range e.IDs() {
+		errs = append(errs, e.Errors[id])
+	}
+	return errs
+}
+
+// GetMany returns the objects with the given ids, in the same order, fetched concurrently.
+// An id given twice is fetched once. When some objects cannot be fetched, their entries are nil
+// and the error is a *BulkError.
+func (rs *AWXResourceService[T]) GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*T, error) {
+	unique := uniqueIDs(ids)
+	objects := make([]*T, len(unique))
+	err := runBulk(ctx, unique, newBulkOptions(opts), func(ctx context.Context, i int) error {
+		object, err := rs.GetByIDContext(ctx, unique[i], nil)
+		objects[i] = object
+		return err
+	})
+
+	byID := make(map[int]*T, len(unique))
+	for i, id := range unique {
+		byID[id] = objects[i]
+	}
+	results := make([]*T, len(ids))
+	for i, id := range ids {
+		results[i] = byID[id]
+	}
+	return results, err
+}
+
+// DeleteMany deletes the objects with the given ids concurrently and returns the ids of the deleted ones.
+// An id given twice is deleted once. When some objects cannot be deleted, the error is a *BulkError listing them.
+// With BulkDryRun, nothing is deleted: the objects are fetched and the ids of the existing ones are returned,
+// the missing ones are listed in the *BulkError.
+func (rs *AWXResourceService[T]) DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error) {
+	options := newBulkOptions(opts)
+	if options.dryRun {
+		return rs.bulk(ctx, uniqueIDs(ids), options, func(ctx context.Context, id int) error {
+			_, err := rs.GetSummary(ctx, id)
+			return err
+		})
+	}
+	return rs.deleteMany(ctx, uniqueIDs(ids), options)
+}
+
+// DeleteWhere deletes every object matching q and returns the ids of the deleted ones, see DeleteMany.
+// q must hold a filter, a nil or empty query is refused with ErrValidation rather than deleting every object.
+// With BulkDryRun, nothing is deleted and the ids of the matching objects are returned.
+func (rs *AWXResourceService[T]) DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error) {
+	if !hasFilter(q) {
+		return nil, fmt.Errorf("%w: DeleteWhere needs a filter, it would delete every object of %s", ErrValidation, rs.basePath)
+	}
+
+	identifiers, err := listAll[objectIdentifiers](ctx, rs.client, rs.basePath, q.Values())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(identifiers))
+	for i, object := range identifiers {
+		ids[i] = object.ID
+	}
+
+	options := newBulkOptions(opts)
+	if options.dryRun {
+		return uniqueIDs(ids), nil
+	}
+	return rs.deleteMany(ctx, uniqueIDs(ids), options)
+}
+
+// deleteMany deletes the objects with the given unique ids.
+func (rs *AWXResourceService[T]) deleteMany(ctx context.Context, ids []int, options *bulkOptions) ([]int, error) {
+	return rs.bulk(ctx, ids, options, func(ctx context.Context, id int) error {
+		_, err := rs.DeleteContext(ctx, id)
+		return err
+	})
+}
+
+// bulk calls fn for each of the unique ids and returns the ids it succeeded for, in the order of ids.
+func (rs *AWXResourceService[T]) bulk(ctx context.Context, ids []int, options *bulkOptions, fn func(ctx context.Context, id int) error) ([]int, error) {
+	succeeded := make([]bool, len(ids))
+	err := runBulk(ctx, ids, options, func(ctx context.Context, i int) error {
+		if err := fn(ctx, ids[i]); err != nil {
+			return err
+		}
+		succeeded[i] = true
+		return nil
+	})
+
+	succeededIDs := make([]int, 0, len(ids))
+	for i, id := range ids {
+		if succeeded[i] {
+			succeededIDs = append(succeededIDs, id)
+		}
+	}
+	return succeededIDs, err
+}
+
+// uniqueIDs returns ids without the repeated ones, in the order of their first occurrence.
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// hasFilter tells whether q restricts the listed objects, the paging and ordering parameters do not.
+func hasFilter(q *Query) bool {
+	for key := range q.Values() {
+		switch key {
+		case "page", "page_size", "order_by":
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// runBulk calls fn with the index of each of the unique ids, running up to the configured concurrency calls at the same time.
+// The ids left once ctx is done fail with the context error.
+func runBulk(ctx context.Context, ids []int, options *bulkOptions, fn func(ctx context.Context, i int) error) error {
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs = map[int]error{}
+	)
+	fail := func(id int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs[id] = err
+	}
+
+	slots := make(chan struct{}, options.concurrency)
+	for i, id := range ids {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			fail(id, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := fn(ctx, i); err != nil {
+				fail(id, err)
+			}
+		}(i, id)
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return &BulkError{Errors: errs}
+}
@@ -0,0 +1,157 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newBulkServer fakes the inventories 1 to 5, the inventory 3 cannot be deleted.
+func newBulkServer(t *testing.T) (*testServer, *[]int, *atomic.Int64) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		deleted  []int
+		inFlight atomic.Int64
+		maxSeen  atomic.Int64
+	)
+	// slow records the number of requests in flight and delays the response
+	slow := func(handler func(w http.ResponseWriter, r *http.Request, id int)) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for seen := maxSeen.Load(); current > seen && !maxSeen.CompareAndSwap(seen, current); seen = maxSeen.Load() {
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			id, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v2/inventories/"), "/"))
+			if r.URL.Path != "/api/v2/inventories/" && (err != nil || id < 1 || id > 5) {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"detail": "Not found."}`)
+				return
+			}
+			handler(w, r, id)
+		}
+	}
+
+	server := newTestServer(t, testRoutes{
+		"GET /api/v2/inventories/": slow(func(w http.ResponseWriter, r *http.Request, _ int) {
+			fmt.Fprint(w, `{"count": 2, "results": [{"id": 2, "name": "ephemeral-2"}, {"id": 3, "name": "ephemeral-3"}]}`)
+		}),
+		"GET /api/v2/inventories/*": slow(func(w http.ResponseWriter, r *http.Request, id int) {
+			fmt.Fprintf(w, `{"id": %d}`, id)
+		}),
+		"DELETE /api/v2/inventories/*": slow(func(w http.ResponseWriter, r *http.Request, id int) {
+			if id == 3 {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"detail": "Resource is being used by running jobs."}`)
+				return
+			}
+			mu.Lock()
+			deleted = append(deleted, id)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	})
+
+	return server, &deleted, &maxSeen
+}
+
+func TestGetMany(t *testing.T) {
+	server, _, maxSeen := newBulkServer(t)
+	rs := NewAWXResourceService[Inventory](server.awxClient(), inventoriesAPIEndpoint, nil)
+
+	inventories, err := rs.GetMany(context.Background(), []int{5, 1, 9, 2, 9}, BulkConcurrency(2))
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("Expecting a *BulkError but got %v", err)
+	}
+	if !reflect.DeepEqual(bulkErr.IDs(), []int{9}) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expecting the inventory 9 not found but got %s", err)
+	}
+
+	if len(bulkErr.Errors) != 1 || server.Requests() != 4 {
+		t.Fatalf("Expecting the inventory 9 fetched once but got %d errors and %d requests", len(bulkErr.Errors), server.Requests())
+	}
+	if len(inventories) != 5 || inventories[0].ID != 5 || inventories[1].ID != 1 || inventories[2] != nil || inventories[3].ID != 2 || inventories[4] != nil {
+		t.Fatalf("Expecting the inventories in the order of the ids but got %v", inventories)
+	}
+	if maxSeen.Load() > 2 {
+		t.Fatalf("Expecting at most 2 requests at the same time but got %d", maxSeen.Load())
+	}
+}
+
+func TestDeleteMany(t *testing.T) {
+	server, deleted, _ := newBulkServer(t)
+	rs := NewAWXResourceService[Inventory](server.awxClient(), inventoriesAPIEndpoint, nil)
+
+	ids, err := rs.DeleteMany(context.Background(), []int{1, 9, 4}, BulkDryRun())
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || !reflect.DeepEqual(bulkErr.IDs(), []int{9}) {
+		t.Fatalf("Expecting the dry run to report the inventory 9 missing but got %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int{1, 4}) || len(*deleted) != 0 {
+		t.Fatalf("Expecting [1 4] reported and nothing deleted but got %v and %v", ids, *deleted)
+	}
+
+	deletedIDs, err := rs.DeleteMany(context.Background(), []int{1, 3, 4, 1})
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("Expecting a conflict but got %v", err)
+	}
+	if !reflect.DeepEqual(deletedIDs, []int{1, 4}) {
+		t.Fatalf("Expecting inventories [1 4] deleted but got %v", deletedIDs)
+	}
+
+	sort.Ints(*deleted)
+	if !reflect.DeepEqual(*deleted, []int{1, 4}) {
+		t.Fatalf("Expecting the server to delete [1 4] but got %v", *deleted)
+	}
+}
+
+func TestDeleteWhere(t *testing.T) {
+	server, deleted, _ := newBulkServer(t)
+	rs := NewAWXResourceService[Inventory](server.awxClient(), inventoriesAPIEndpoint, nil)
+	q := NewQuery().Filter("name", StartsWith, "ephemeral-")
+
+	ids, err := rs.DeleteWhere(context.Background(), q, BulkDryRun())
+	if err != nil {
+		t.Fatalf("DeleteWhere dry run err: %s", err)
+	}
+	if !reflect.DeepEqual(ids, []int{2, 3}) || len(*deleted) != 0 {
+		t.Fatalf("Expecting [2 3] reported and nothing deleted but got %v and %v", ids, *deleted)
+	}
+
+	ids, err = rs.DeleteWhere(context.Background(), q)
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || !reflect.DeepEqual(bulkErr.IDs(), []int{3}) {
+		t.Fatalf("Expecting the inventory 3 to fail but got %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int{2}) {
+		t.Fatalf("Expecting the inventory 2 deleted but got %v", ids)
+	}
+}
+
+func TestDeleteWhereWithoutFilter(t *testing.T) {
+	server, deleted, _ := newBulkServer(t)
+	rs := NewAWXResourceService[Inventory](server.awxClient(), inventoriesAPIEndpoint, nil)
+
+	for _, q := range []*Query{nil, NewQuery(), NewQuery().OrderBy("name").PageSize(50)} {
+		if _, err := rs.DeleteWhere(context.Background(), q); !errors.Is(err, ErrValidation) {
+			t.Fatalf("Expecting ErrValidation for %q but got %v", q.Encode(), err)
+		}
+	}
+	if server.Requests() != 0 || len(*deleted) != 0 {
+		t.Fatalf("Expecting no request but got %d and %v deleted", server.Requests(), *deleted)
+	}
+}
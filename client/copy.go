@@ -0,0 +1,61 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Copy clones the object with the given id under newName. Only the resources whose service
+// lists Copy can be copied: job and workflow job templates, projects, inventories, credentials
+// and notification templates.
+func (rs *AWXResourceService[T]) Copy(id int, newName string) (*T, error) {
+	return rs.CopyContext(context.Background(), id, newName)
+}
+
+// CopyContext is the context-aware version of Copy.
+func (rs *AWXResourceService[T]) CopyContext(ctx context.Context, id int, newName string) (*T, error) {
+	if newName == "" {
+		return nil, newMandatoryFieldsError([]string{"name"})
+	}
+
+	result := new(T)
+	endpoint := fmt.Sprintf("%s%d/copy/", rs.basePath, id)
+	payload, err := json.Marshal(map[string]string{"name": newName})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rs.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CanCopy tells whether the current user may copy the object with the given id, and which
+// related objects it cannot copy.
+func (rs *AWXResourceService[T]) CanCopy(id int) (*CopyCapability, error) {
+	return rs.CanCopyContext(context.Background(), id)
+}
+
+// CanCopyContext is the context-aware version of CanCopy.
+func (rs *AWXResourceService[T]) CanCopyContext(ctx context.Context, id int) (*CopyCapability, error) {
+	result := new(CopyCapability)
+	endpoint := fmt.Sprintf("%s%d/copy/", rs.basePath, id)
+	resp, err := rs.client.Requester.GetJSONContext(ctx, endpoint, result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCopy(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/v2/job_templates/5/copy/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"can_copy": true, "can_copy_without_user_input": false, "credentials_unable_to_copy": ["vault"]}`)
+		},
+		"POST /api/v2/job_templates/5/copy/": func(w http.ResponseWriter, r *http.Request) {
+			body := map[string]string{}
+			json.NewDecoder(r.Body).Decode(&body)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": 6, "name": %q}`, body["name"])
+		},
+	})
+
+	rs := NewAWXResourceService[JobTemplate](server.awxClient(), jobTemplatesAPIEndpoint, nil)
+
+	capability, err := rs.CanCopy(5)
+	if err != nil {
+		t.Fatalf("CanCopy err: %s", err)
+	}
+	if !capability.CanCopy || capability.CanCopyWithoutUserInput || len(capability.CredentialsUnableToCopy) != 1 {
+		t.Fatalf("Expecting the copy capability to be decoded but got %+v", capability)
+	}
+
+	copied, err := rs.Copy(5, "deploy-staging")
+	if err != nil {
+		t.Fatalf("Copy err: %s", err)
+	}
+	if copied.ID != 6 || copied.Name != "deploy-staging" {
+		t.Fatalf("Expecting the copy deploy-staging but got %+v", copied)
+	}
+
+	if _, err := rs.Copy(5, ""); !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expecting a validation error without a name but got %v", err)
+	}
+}
@@ -1,11 +1,37 @@
 package awx
 
+import "context"
+
 type CredentialInputSourceService interface {
 	List(params map[string]string) ([]*CredentialInputSource, *ResultsList[CredentialInputSource], error)
 	GetByID(id int, params map[string]string) (*CredentialInputSource, error)
 	Create(data map[string]interface{}, params map[string]string) (*CredentialInputSource, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*CredentialInputSource, error)
 	Delete(id int) (*CredentialInputSource, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*CredentialInputSource, *ResultsList[CredentialInputSource], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*CredentialInputSource, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*CredentialInputSource, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*CredentialInputSource, error)
+	DeleteContext(ctx context.Context, id int) (*CredentialInputSource, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*CredentialInputSource, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[CredentialInputSource]
+	ListQuery(ctx context.Context, q *Query) ([]*CredentialInputSource, *ResultsList[CredentialInputSource], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*CredentialInputSource, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[CredentialInputSource]
+	CreateWith(ctx context.Context, req CreateRequest[CredentialInputSource], params map[string]string) (*CredentialInputSource, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[CredentialInputSource], params map[string]string) (*CredentialInputSource, error)
+	GetByName(name string, scope map[string]string) (*CredentialInputSource, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*CredentialInputSource, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*CredentialInputSource, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*CredentialInputSource, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[CredentialInputSource]) (*EnsureResult[CredentialInputSource], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*CredentialInputSource, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type credentialInputSourceServiceHTTP struct {
@@ -19,3 +45,42 @@ type ListCredentialInputSourceResponse struct {
 }
 
 const credentialInputSourceAPIEndpoint = "/api/v2/credential_input_sources/"
+
+// CredentialInputSourceCreateRequest links an input field of the target credential to
+// the external source credential providing its value.
+type CredentialInputSourceCreateRequest struct {
+	Description      string                 `json:"description,omitempty"`
+	TargetCredential int                    `json:"target_credential"`
+	SourceCredential int                    `json:"source_credential"`
+	InputFieldName   string                 `json:"input_field_name"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *CredentialInputSourceCreateRequest) Validate() error {
+	return checkFields(
+		required("target_credential", r.TargetCredential != 0),
+		required("source_credential", r.SourceCredential != 0),
+		required("input_field_name", r.InputFieldName != ""),
+	)
+}
+
+func (*CredentialInputSourceCreateRequest) createRequest(*CredentialInputSource) {}
+
+// CredentialInputSourceUpdateRequest changes the fields of an existing input source, the nil ones are not sent.
+type CredentialInputSourceUpdateRequest struct {
+	Description      *string                `json:"description,omitempty"`
+	TargetCredential *int                   `json:"target_credential,omitempty"`
+	SourceCredential *int                   `json:"source_credential,omitempty"`
+	InputFieldName   *string                `json:"input_field_name,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *CredentialInputSourceUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("input_field_name", r.InputFieldName),
+	)
+}
+
+func (*CredentialInputSourceUpdateRequest) updateRequest(*CredentialInputSource) {}
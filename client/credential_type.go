@@ -1,11 +1,37 @@
 package awx
 
+import "context"
+
 type CredentialTypeService interface {
 	List(params map[string]string) ([]*CredentialType, *ResultsList[CredentialType], error)
 	Create(data map[string]interface{}, params map[string]string) (*CredentialType, error)
 	GetByID(id int, params map[string]string) (*CredentialType, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*CredentialType, error)
 	Delete(id int) (*CredentialType, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*CredentialType, *ResultsList[CredentialType], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*CredentialType, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*CredentialType, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*CredentialType, error)
+	DeleteContext(ctx context.Context, id int) (*CredentialType, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*CredentialType, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[CredentialType]
+	ListQuery(ctx context.Context, q *Query) ([]*CredentialType, *ResultsList[CredentialType], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*CredentialType, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[CredentialType]
+	CreateWith(ctx context.Context, req CreateRequest[CredentialType], params map[string]string) (*CredentialType, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[CredentialType], params map[string]string) (*CredentialType, error)
+	GetByName(name string, scope map[string]string) (*CredentialType, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*CredentialType, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*CredentialType, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*CredentialType, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[CredentialType]) (*EnsureResult[CredentialType], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*CredentialType, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type credentialTypeServiceHTTP struct {
@@ -19,3 +45,43 @@ type ListCredentialTypeResponse struct {
 }
 
 const credentialTypesAPIEndpoint = "/api/v2/credential_types/"
+
+// CredentialTypeCreateRequest describes a custom credential type, of kind cloud or net.
+type CredentialTypeCreateRequest struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Kind        string                 `json:"kind"`
+	Inputs      map[string]interface{} `json:"inputs,omitempty"`
+	Injectors   map[string]interface{} `json:"injectors,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *CredentialTypeCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("kind", r.Kind != ""),
+		oneOf("kind", r.Kind, "cloud", "net"),
+	)
+}
+
+func (*CredentialTypeCreateRequest) createRequest(*CredentialType) {}
+
+// CredentialTypeUpdateRequest changes a custom credential type, only the fields set are sent.
+type CredentialTypeUpdateRequest struct {
+	Name        *string                `json:"name,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Kind        *string                `json:"kind,omitempty"`
+	Inputs      map[string]interface{} `json:"inputs,omitempty"`
+	Injectors   map[string]interface{} `json:"injectors,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *CredentialTypeUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		notEmpty("kind", r.Kind),
+		oneOf("kind", deref(r.Kind), "cloud", "net"),
+	)
+}
+
+func (*CredentialTypeUpdateRequest) updateRequest(*CredentialType) {}
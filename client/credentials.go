@@ -1,11 +1,41 @@
 package awx
 
+import "context"
+
 type CredentialService interface {
 	List(params map[string]string) ([]*Credential, *ResultsList[Credential], error)
 	GetByID(id int, params map[string]string) (*Credential, error)
 	Create(data map[string]interface{}, params map[string]string) (*Credential, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Credential, error)
 	Delete(id int) (*Credential, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Credential, *ResultsList[Credential], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Credential, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Credential, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Credential, error)
+	DeleteContext(ctx context.Context, id int) (*Credential, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Credential, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Credential]
+	ListQuery(ctx context.Context, q *Query) ([]*Credential, *ResultsList[Credential], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Credential, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Credential]
+	CreateWith(ctx context.Context, req CreateRequest[Credential], params map[string]string) (*Credential, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Credential], params map[string]string) (*Credential, error)
+	GetByName(name string, scope map[string]string) (*Credential, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Credential, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Credential, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Credential, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Credential]) (*EnsureResult[Credential], error)
+	Copy(id int, newName string) (*Credential, error)
+	CopyContext(ctx context.Context, id int, newName string) (*Credential, error)
+	CanCopy(id int) (*CopyCapability, error)
+	CanCopyContext(ctx context.Context, id int) (*CopyCapability, error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Credential, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type credentialServiceHTTP struct {
@@ -19,3 +49,44 @@ type ListCredentialsResponse struct {
 }
 
 const credentialsAPIEndpoint = "/api/v2/credentials/"
+
+// CredentialCreateRequest holds the name, the credential type and the inputs of a new credential.
+type CredentialCreateRequest struct {
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description,omitempty"`
+	CredentialType int                    `json:"credential_type"`
+	Organization   int                    `json:"organization,omitempty"`
+	User           int                    `json:"user,omitempty"`
+	Team           int                    `json:"team,omitempty"`
+	Inputs         map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *CredentialCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("credential_type", r.CredentialType != 0),
+	)
+}
+
+func (*CredentialCreateRequest) createRequest(*Credential) {}
+
+// CredentialUpdateRequest patches a credential, e.g. renames it or gives new inputs.
+type CredentialUpdateRequest struct {
+	Name           *string                `json:"name,omitempty"`
+	Description    *string                `json:"description,omitempty"`
+	CredentialType *int                   `json:"credential_type,omitempty"`
+	Organization   *int                   `json:"organization,omitempty"`
+	User           *int                   `json:"user,omitempty"`
+	Team           *int                   `json:"team,omitempty"`
+	Inputs         map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *CredentialUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+	)
+}
+
+func (*CredentialUpdateRequest) updateRequest(*Credential) {}
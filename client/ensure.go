@@ -0,0 +1,214 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// EnsureAction tells what Ensure did to reach the desired state.
+type EnsureAction string
+
+// Actions reported by Ensure.
+const (
+	EnsureCreated   EnsureAction = "created"
+	EnsureUpdated   EnsureAction = "updated"
+	EnsureUnchanged EnsureAction = "unchanged"
+)
+
+// encryptedValue is returned by awx in place of the secrets, which cannot be compared.
+const encryptedValue = "$encrypted$"
+
+// EnsureResult is the outcome of Ensure.
+type EnsureResult[T any] struct {
+	Object *T
+	Action EnsureAction
+	// Changed lists the fields patched by an update.
+	Changed []string
+}
+
+// withNaturalKey returns a copy of rs identifying its objects by fields in Ensure.
+func (rs AWXResourceService[T]) withNaturalKey(fields ...string) AWXResourceService[T] {
+	rs.naturalKey = fields
+	return rs
+}
+
+// withNullableKey returns a copy of rs whose natural key fields may be null: when unset in
+// the desired object, Ensure looks for an object where they are null.
+func (rs AWXResourceService[T]) withNullableKey(fields ...string) AWXResourceService[T] {
+	rs.nullableKey = make(map[string]bool, len(fields))
+	for _, field := range fields {
+		rs.nullableKey[field] = true
+	}
+	return rs
+}
+
+// Ensure makes the object identified by identity match desired: the object is created if
+// missing, only its fields differing from desired are patched otherwise.
+//
+// identity holds the list filters identifying the object, e.g. `{"name": "web-01", "inventory": "3"}`.
+// When nil, the natural key of the resource is taken from desired, e.g. the name and the
+// organization of a project or the name and the inventory of a host. Every field of the natural
+// key must be set, except the optional ones such as the organization of a credential: when unset,
+// the object without any is looked for.
+//
+// Only the fields set in desired are compared: the optional fields left to their zero value, or to
+// nil for the pointers, are omitted from the request and keep their live value. Thus a boolean is
+// turned off with a pointer to false, while a string or a number cannot be reset to its zero
+// value, which UpdateWith does. Secrets returned as `$encrypted$` by awx cannot be compared and are
+// only set at creation.
+func (rs *AWXResourceService[T]) Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[T]) (*EnsureResult[T], error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+
+	fields, err := toJSONMap(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity == nil {
+		identity, err = rs.naturalIdentity(fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	params := make(map[string]string, len(identity)+1)
+	for key, value := range identity {
+		params[key] = value
+	}
+	params["page_size"] = "2"
+
+	// the live objects are decoded as maps to compare every field awx returns
+	list := new(ResultsList[map[string]interface{}])
+	resp, err := rs.client.Requester.GetJSONContext(ctx, rs.basePath, list, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(list.Results) == 0:
+		created, err := rs.CreateWith(ctx, desired, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &EnsureResult[T]{Object: created, Action: EnsureCreated}, nil
+	case len(list.Results) > 1 || list.Count > 1:
+		return nil, fmt.Errorf("%w: %d objects of %s match %v", ErrAmbiguous, list.Count, rs.basePath, identity)
+	}
+
+	live := *list.Results[0]
+	id, ok := live["id"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("awx: no id in the object of %s matching %v", rs.basePath, identity)
+	}
+
+	patch := make(map[string]interface{})
+	for field, value := range fields {
+		if !equalJSON(value, live[field]) {
+			patch[field] = value
+		}
+	}
+
+	if len(patch) == 0 {
+		object := new(T)
+		if err := fromJSONMap(live, object); err != nil {
+			return nil, err
+		}
+		return &EnsureResult[T]{Object: object, Action: EnsureUnchanged}, nil
+	}
+
+	updated, err := rs.UpdateContext(ctx, int(id), patch, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]string, 0, len(patch))
+	for field := range patch {
+		changed = append(changed, field)
+	}
+	sort.Strings(changed)
+
+	return &EnsureResult[T]{Object: updated, Action: EnsureUpdated, Changed: changed}, nil
+}
+
+// naturalIdentity builds the list filters matching the natural key of the resource in fields.
+func (rs *AWXResourceService[T]) naturalIdentity(fields map[string]interface{}) (map[string]string, error) {
+	if len(rs.naturalKey) == 0 {
+		return nil, fmt.Errorf("awx: %s has no natural key, an identity is required", rs.basePath)
+	}
+
+	identity := make(map[string]string, len(rs.naturalKey))
+	for _, key := range rs.naturalKey {
+		switch value := fields[key].(type) {
+		case nil:
+			// an unset key would widen the lookup to the objects of any organization or inventory
+			if !rs.nullableKey[key] {
+				return nil, fmt.Errorf("%w: %s is part of the natural key of %s, set it or give an identity", ErrValidation, key, rs.basePath)
+			}
+			identity[key+"__isnull"] = "true"
+		case string:
+			identity[key] = value
+		case float64:
+			identity[key] = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			identity[key] = fmt.Sprint(value)
+		}
+	}
+	return identity, nil
+}
+
+// equalJSON compares a desired value to the live one, both decoded from JSON.
+// The fields of desired objects absent from the live objects are ignored, so are encrypted values.
+func equalJSON(desired, live interface{}) bool {
+	if live == encryptedValue {
+		return true
+	}
+
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(desired, live)
+	}
+
+	liveMap, ok := live.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for key, value := range desiredMap {
+		if !equalJSON(value, liveMap[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// toJSONMap converts v to the map of its JSON fields.
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.NewDecoder(bytes.NewReader(payload)).Decode(&fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// fromJSONMap decodes the JSON fields of m into v.
+func fromJSONMap(m map[string]interface{}, v interface{}) error {
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(payload, v)
+}
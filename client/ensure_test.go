@@ -0,0 +1,191 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// newHostsServer fakes the hosts endpoint of an inventory holding the given hosts.
+func newHostsServer(t *testing.T, hosts map[string]map[string]interface{}) *testServer {
+	t.Helper()
+
+	var mu sync.Mutex
+	return newTestServer(t, testRoutes{
+		"GET " + hostsAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			results := []map[string]interface{}{}
+			if host, ok := hosts[r.URL.Query().Get("name")]; ok && r.URL.Query().Get("inventory") == "1" {
+				results = append(results, host)
+			}
+			writeJSON(w, map[string]interface{}{"count": len(results), "results": results})
+		},
+		"POST " + hostsAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			host := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&host)
+			host["id"] = len(hosts) + 1
+			hosts[host["name"].(string)] = host
+			writeJSON(w, host)
+		},
+		"PATCH " + hostsAPIEndpoint + "*": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			patch := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&patch)
+			for _, host := range hosts {
+				if r.URL.Path == fmt.Sprintf("%s%v/", hostsAPIEndpoint, host["id"]) {
+					for field, value := range patch {
+						host[field] = value
+					}
+					writeJSON(w, host)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		},
+	})
+}
+
+func TestEnsure(t *testing.T) {
+	server := newHostsServer(t, map[string]map[string]interface{}{
+		"web-01": {"id": 1, "name": "web-01", "inventory": 1, "description": "web", "enabled": true},
+	})
+
+	rs := NewAWXResourceService[Host](server.awxClient(), hostsAPIEndpoint, nil).withNaturalKey("name", "inventory")
+
+	testTable := []struct {
+		name        string
+		desired     *HostCreateRequest
+		wantAction  EnsureAction
+		wantChanged []string
+		wantID      int
+	}{
+		{
+			name:       "unchanged",
+			desired:    &HostCreateRequest{Name: "web-01", Inventory: 1, Description: "web"},
+			wantAction: EnsureUnchanged,
+			wantID:     1,
+		},
+		{
+			name:        "updated",
+			desired:     &HostCreateRequest{Name: "web-01", Inventory: 1, Description: "frontend", Enabled: Ptr(false)},
+			wantAction:  EnsureUpdated,
+			wantChanged: []string{"description", "enabled"},
+			wantID:      1,
+		},
+		{
+			name:       "created",
+			desired:    &HostCreateRequest{Name: "db-01", Inventory: 1},
+			wantAction: EnsureCreated,
+			wantID:     2,
+		},
+		{
+			name:       "created host is found again",
+			desired:    &HostCreateRequest{Name: "db-01", Inventory: 1},
+			wantAction: EnsureUnchanged,
+			wantID:     2,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := rs.Ensure(context.Background(), nil, test.desired)
+			if err != nil {
+				t.Fatalf("Ensure err: %s", err)
+			}
+
+			if result.Action != test.wantAction {
+				t.Fatalf("Expecting %s but got %s", test.wantAction, result.Action)
+			}
+			if !reflect.DeepEqual(result.Changed, test.wantChanged) {
+				t.Fatalf("Expecting %v to be changed but got %v", test.wantChanged, result.Changed)
+			}
+			if result.Object.ID != test.wantID {
+				t.Fatalf("Expecting host %d but got %d", test.wantID, result.Object.ID)
+			}
+		})
+	}
+}
+
+func TestEnsureNaturalKey(t *testing.T) {
+	var query string
+	server := newTestServer(t, testRoutes{"GET " + credentialsAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"count": 1, "results": [{"id": 5, "name": "vault", "credential_type": 2, "organization": null}]}`)
+	}})
+	client := server.awxClient()
+
+	credentials := NewAWXResourceService[Credential](client, credentialsAPIEndpoint, nil).
+		withNaturalKey("name", "organization", "credential_type").withNullableKey("organization")
+	result, err := credentials.Ensure(context.Background(), nil, &CredentialCreateRequest{Name: "vault", CredentialType: 2})
+	if err != nil {
+		t.Fatalf("Ensure err: %s", err)
+	}
+	if result.Action != EnsureUnchanged || query != "credential_type=2&name=vault&organization__isnull=true&page_size=2" {
+		t.Fatalf("Expecting the credential without organization to be looked up but got %s %s", result.Action, query)
+	}
+
+	nodes := NewAWXResourceService[WorkflowJobTemplateNode](client, workflowJobTemplateNodeAPIEndpoint, nil).
+		withNaturalKey("workflow_job_template", "identifier")
+	before := server.Requests()
+	_, err = nodes.Ensure(context.Background(), nil, &WorkflowJobTemplateNodeCreateRequest{WorkflowJobTemplate: 1, UnifiedJobTemplate: 2})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expecting a validation error without identifier but got %v", err)
+	}
+	if server.Requests() != before {
+		t.Fatalf("Expecting no lookup without identifier")
+	}
+}
+
+func TestEnsureJobTemplateOtherProject(t *testing.T) {
+	var query, patch string
+	server := newTestServer(t, testRoutes{
+		"GET " + jobTemplatesAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.RawQuery
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 4, "name": "deploy", "organization": 3, "inventory": 2, "project": 7, "playbook": "site.yml"}]}`)
+		},
+		"PATCH " + jobTemplatesAPIEndpoint + "4/": func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			patch = string(body)
+			fmt.Fprint(w, `{"id": 4, "name": "deploy", "organization": 3, "inventory": 2, "project": 8, "playbook": "site.yml"}`)
+		},
+	})
+	jobTemplates := newAWX(server.awxClient()).JobTemplateService
+
+	result, err := jobTemplates.Ensure(context.Background(), nil, &JobTemplateCreateRequest{
+		Name: "deploy", Organization: 3, Inventory: 2, Project: 8, Playbook: "site.yml",
+	})
+	if err != nil {
+		t.Fatalf("Ensure err: %s", err)
+	}
+	if query != "name=deploy&organization=3&page_size=2" {
+		t.Fatalf("Expecting the job template to be looked up by name and organization but got %s", query)
+	}
+	if result.Action != EnsureUpdated || !reflect.DeepEqual(result.Changed, []string{"project"}) {
+		t.Fatalf("Expecting the project of the job template to be updated but got %s %v (%s)", result.Action, result.Changed, patch)
+	}
+}
+
+func TestEqualJSONIgnoresEncryptedValues(t *testing.T) {
+	desired := map[string]interface{}{"username": "svc", "password": "s3cr3t"} // pragma: allowlist secret
+	live := map[string]interface{}{"username": "svc", "password": encryptedValue, "ssh_key_unlock": ""}
+
+	if !equalJSON(desired, live) {
+		t.Fatalf("Expecting the encrypted password not to be compared")
+	}
+	if equalJSON(map[string]interface{}{"username": "other"}, live) {
+		t.Fatalf("Expecting a different username to be detected")
+	}
+}
@@ -0,0 +1,158 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors matched by an *APIError through errors.Is.
+var (
+	ErrUnauthorized = errors.New("awx: unauthorized")
+	ErrForbidden    = errors.New("awx: forbidden")
+	ErrNotFound     = errors.New("awx: not found")
+	ErrConflict     = errors.New("awx: conflict")
+	ErrValidation   = errors.New("awx: validation failed")
+	// ErrAmbiguous is returned by a lookup matching several objects.
+	ErrAmbiguous = errors.New("awx: ambiguous lookup")
+)
+
+// maxErrorBodySize limits how much of an error response body is kept in an APIError.
+const maxErrorBodySize = 1 << 20
+
+// APIError represents an error response returned by the awx api.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// Detail is the `detail` message of the awx error payload, if any.
+	Detail string
+	// FieldErrors maps a field name (or `__all__`) to its validation messages.
+	FieldErrors map[string][]string
+	// Body is the raw response body.
+	Body []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("awx: %s %s: %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Detail != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Detail)
+	}
+
+	if len(e.FieldErrors) > 0 {
+		fields := make([]string, 0, len(e.FieldErrors))
+		for field := range e.FieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			msg = fmt.Sprintf("%s\n- %s: %s", msg, field, strings.Join(e.FieldErrors[field], " "))
+		}
+	}
+
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest
+	}
+	return false
+}
+
+// newAPIError builds an APIError from a non successful response, consuming its body.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+
+	if resp.Body == nil {
+		return apiErr
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+	apiErr.Body = body
+	apiErr.decodeBody()
+
+	return apiErr
+}
+
+// decodeBody fills Detail and FieldErrors from the awx error payload.
+// awx returns either `{"detail": "..."}` or a map of field names to messages.
+func (e *APIError) decodeBody() {
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(e.Body, &payload); err != nil {
+		return
+	}
+
+	for key, raw := range payload {
+		if key == "detail" {
+			var detail string
+			if err := json.Unmarshal(raw, &detail); err == nil {
+				e.Detail = detail
+				continue
+			}
+		}
+
+		if e.FieldErrors == nil {
+			e.FieldErrors = map[string][]string{}
+		}
+
+		var messages []string
+		if err := json.Unmarshal(raw, &messages); err == nil {
+			e.FieldErrors[key] = messages
+			continue
+		}
+
+		var message string
+		if err := json.Unmarshal(raw, &message); err == nil {
+			e.FieldErrors[key] = []string{message}
+			continue
+		}
+
+		// nested errors, e.g. credential inputs
+		e.FieldErrors[key] = []string{string(raw)}
+	}
+}
+
+// mandatoryFieldsError is returned when mandatory input arguments are absent, it matches ErrValidation.
+// Its message is the lowercase one most services used before the sentinel errors, the hosts and the
+// job templates used to capitalize it.
+type mandatoryFieldsError struct {
+	notfound []string
+}
+
+func (e *mandatoryFieldsError) Error() string {
+	return fmt.Sprintf("mandatory input arguments are absent: %s", e.notfound)
+}
+
+func (e *mandatoryFieldsError) Unwrap() error {
+	return ErrValidation
+}
+
+// newMandatoryFieldsError returns the error used when mandatory input arguments are absent.
+func newMandatoryFieldsError(notfound []string) error {
+	return &mandatoryFieldsError{notfound: notfound}
+}
@@ -0,0 +1,140 @@
+package awx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newErrorResponse(statusCode int, body string) *http.Response {
+	req, _ := http.NewRequest(http.MethodPost, "http://awx.example.com/api/v2/hosts/", nil)
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	testTable := []struct {
+		name            string
+		body            string
+		wantDetail      string
+		wantFieldErrors map[string][]string
+	}{
+		{
+			name:       "detail",
+			body:       `{"detail": "Not found."}`,
+			wantDetail: "Not found.",
+		},
+		{
+			name:            "all",
+			body:            `{"__all__": ["Host with this Name and Inventory already exists."]}`,
+			wantFieldErrors: map[string][]string{"__all__": {"Host with this Name and Inventory already exists."}},
+		},
+		{
+			name: "fields",
+			body: `{"name": ["This field may not be blank."], "inventory": "Invalid pk.", "inputs": {"password": ["Required"]}}`,
+			wantFieldErrors: map[string][]string{
+				"name":      {"This field may not be blank."},
+				"inventory": {"Invalid pk."},
+				"inputs":    {`{"password": ["Required"]}`},
+			},
+		},
+		{
+			name: "not json",
+			body: `<html>Bad Gateway</html>`,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			apiErr := newAPIError(newErrorResponse(http.StatusBadRequest, test.body))
+
+			if apiErr.Method != http.MethodPost || apiErr.URL != "http://awx.example.com/api/v2/hosts/" {
+				t.Fatalf("Expecting the request method and URL but got %s %s", apiErr.Method, apiErr.URL)
+			}
+			if string(apiErr.Body) != test.body {
+				t.Fatalf("Expecting the raw body %q but got %q", test.body, apiErr.Body)
+			}
+			if apiErr.Detail != test.wantDetail {
+				t.Fatalf("Expecting detail %q but got %q", test.wantDetail, apiErr.Detail)
+			}
+			if !reflect.DeepEqual(apiErr.FieldErrors, test.wantFieldErrors) {
+				t.Fatalf("Expecting field errors %v but got %v", test.wantFieldErrors, apiErr.FieldErrors)
+			}
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	apiErr := newAPIError(newErrorResponse(http.StatusBadRequest, `{"name": ["Required."], "__all__": ["Duplicate."]}`))
+
+	want := "awx: POST http://awx.example.com/api/v2/hosts/: 400 Bad Request\n- __all__: Duplicate.\n- name: Required."
+	if apiErr.Error() != want {
+		t.Fatalf("Expecting %q but got %q", want, apiErr.Error())
+	}
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	sentinels := []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict, ErrValidation}
+	testTable := []struct {
+		statusCode int
+		want       error
+	}{
+		{statusCode: http.StatusUnauthorized, want: ErrUnauthorized},
+		{statusCode: http.StatusForbidden, want: ErrForbidden},
+		{statusCode: http.StatusNotFound, want: ErrNotFound},
+		{statusCode: http.StatusConflict, want: ErrConflict},
+		{statusCode: http.StatusBadRequest, want: ErrValidation},
+		{statusCode: http.StatusInternalServerError},
+	}
+
+	for _, test := range testTable {
+		t.Run(http.StatusText(test.statusCode), func(t *testing.T) {
+			// the error is wrapped, as a caller would
+			err := fmt.Errorf("create host: %w", newAPIError(newErrorResponse(test.statusCode, "")))
+
+			for _, sentinel := range sentinels {
+				if errors.Is(err, sentinel) != (sentinel == test.want) {
+					t.Fatalf("Expecting errors.Is(%s) to be %t", sentinel, sentinel == test.want)
+				}
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != test.statusCode {
+				t.Fatalf("Expecting an *APIError with status %d but got %v", test.statusCode, err)
+			}
+		})
+	}
+}
+
+func TestMandatoryFieldsError(t *testing.T) {
+	err := newMandatoryFieldsError([]string{"name", "inventory"})
+
+	if err.Error() != "mandatory input arguments are absent: [name inventory]" {
+		t.Fatalf("Expecting the historical message but got %q", err.Error())
+	}
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expecting the error to match ErrValidation")
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Fatalf("Expecting no *APIError, no request was sent")
+	}
+}
+
+func TestLaunchJobWithoutJobID(t *testing.T) {
+	server := newTestServer(t, testRoutes{"/api/v2/job_templates/1/launch/": func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"job": 0}`)
+	}})
+	jobTemplates := &jobTemplateServiceHTTP{client: server.awxClient()}
+
+	if _, err := jobTemplates.LaunchJob(1, nil, nil); !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expecting ErrValidation but got %v", err)
+	}
+}
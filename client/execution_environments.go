@@ -1,11 +1,37 @@
 package awx
 
+import "context"
+
 type ExecutionEnvironmentService interface {
 	List(params map[string]string) ([]*ExecutionEnvironment, *ResultsList[ExecutionEnvironment], error)
 	GetByID(id int, params map[string]string) (*ExecutionEnvironment, error)
 	Create(data map[string]interface{}, params map[string]string) (*ExecutionEnvironment, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*ExecutionEnvironment, error)
 	Delete(id int) (*ExecutionEnvironment, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*ExecutionEnvironment, *ResultsList[ExecutionEnvironment], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*ExecutionEnvironment, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*ExecutionEnvironment, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*ExecutionEnvironment, error)
+	DeleteContext(ctx context.Context, id int) (*ExecutionEnvironment, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*ExecutionEnvironment, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[ExecutionEnvironment]
+	ListQuery(ctx context.Context, q *Query) ([]*ExecutionEnvironment, *ResultsList[ExecutionEnvironment], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*ExecutionEnvironment, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[ExecutionEnvironment]
+	CreateWith(ctx context.Context, req CreateRequest[ExecutionEnvironment], params map[string]string) (*ExecutionEnvironment, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[ExecutionEnvironment], params map[string]string) (*ExecutionEnvironment, error)
+	GetByName(name string, scope map[string]string) (*ExecutionEnvironment, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*ExecutionEnvironment, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*ExecutionEnvironment, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*ExecutionEnvironment, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[ExecutionEnvironment]) (*EnsureResult[ExecutionEnvironment], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*ExecutionEnvironment, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type executionEnvironmentServiceHTTP struct {
@@ -20,3 +46,45 @@ type ListExecutionEnvironmentsResponse struct {
 }
 
 const executionEnvironmentsAPIEndpoint = "/api/v2/execution_environments/"
+
+// ExecutionEnvironmentCreateRequest registers a container image as an execution environment.
+type ExecutionEnvironmentCreateRequest struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Organization int    `json:"organization,omitempty"`
+	Image        string `json:"image"`
+	Credential   int    `json:"credential,omitempty"`
+	Pull         string `json:"pull,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *ExecutionEnvironmentCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("image", r.Image != ""),
+		oneOf("pull", r.Pull, "always", "missing", "never"),
+	)
+}
+
+func (*ExecutionEnvironmentCreateRequest) createRequest(*ExecutionEnvironment) {}
+
+// ExecutionEnvironmentUpdateRequest changes the image or the pull policy of an execution environment.
+type ExecutionEnvironmentUpdateRequest struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Organization *int    `json:"organization,omitempty"`
+	Image        *string `json:"image,omitempty"`
+	Credential   *int    `json:"credential,omitempty"`
+	Pull         *string `json:"pull,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *ExecutionEnvironmentUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		notEmpty("image", r.Image),
+		oneOf("pull", deref(r.Pull), "always", "missing", "never"),
+	)
+}
+
+func (*ExecutionEnvironmentUpdateRequest) updateRequest(*ExecutionEnvironment) {}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -10,6 +11,12 @@ type AWXResourceService[T any] struct {
 	client          *Client
 	basePath        string
 	mandatoryFields []string
+	// nameField is the field looked up by GetByName, `name` when empty.
+	nameField string
+	// naturalKey lists the fields identifying an object in Ensure.
+	naturalKey []string
+	// nullableKey lists the fields of the natural key which may be null, e.g. the organization of a credential.
+	nullableKey map[string]bool
 }
 
 func NewAWXResourceService[T any](client *Client, basepath string, mandatoryFields []string) AWXResourceService[T] {
@@ -20,14 +27,24 @@ func NewAWXResourceService[T any](client *Client, basepath string, mandatoryFiel
 	}
 }
 
+// withNameField returns a copy of rs looking up the objects by field instead of `name`.
+func (rs AWXResourceService[T]) withNameField(field string) AWXResourceService[T] {
+	rs.nameField = field
+	return rs
+}
+
 type ResultsList[T any] struct {
 	Pagination
 	Results []*T `json:"results"`
 }
 
 func (rs *AWXResourceService[T]) List(params map[string]string) ([]*T, *ResultsList[T], error) {
+	return rs.ListContext(context.Background(), params)
+}
+
+func (rs *AWXResourceService[T]) ListContext(ctx context.Context, params map[string]string) ([]*T, *ResultsList[T], error) {
 	result := new(ResultsList[T])
-	resp, err := rs.client.Requester.GetJSON(rs.basePath, result, params)
+	resp, err := rs.client.Requester.GetJSONContext(ctx, rs.basePath, result, params)
 	if err != nil {
 		return nil, result, err
 	}
@@ -40,9 +57,13 @@ func (rs *AWXResourceService[T]) List(params map[string]string) ([]*T, *ResultsL
 }
 
 func (rs *AWXResourceService[T]) GetByID(id int, params map[string]string) (*T, error) {
+	return rs.GetByIDContext(context.Background(), id, params)
+}
+
+func (rs *AWXResourceService[T]) GetByIDContext(ctx context.Context, id int, params map[string]string) (*T, error) {
 	result := new(T)
 	endpoint := fmt.Sprintf("%s%d/", rs.basePath, id)
-	resp, err := rs.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := rs.client.Requester.GetJSONContext(ctx, endpoint, result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -53,12 +74,16 @@ func (rs *AWXResourceService[T]) GetByID(id int, params map[string]string) (*T,
 
 	return result, nil
 }
+
 func (rs *AWXResourceService[T]) Create(data map[string]interface{}, params map[string]string) (*T, error) {
+	return rs.CreateContext(context.Background(), data, params)
+}
+
+func (rs *AWXResourceService[T]) CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*T, error) {
 	validate, status := ValidateParams(data, rs.mandatoryFields)
 
 	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
+		return nil, newMandatoryFieldsError(validate)
 	}
 
 	result := new(T)
@@ -66,7 +91,7 @@ func (rs *AWXResourceService[T]) Create(data map[string]interface{}, params map[
 	if err != nil {
 		return nil, err
 	}
-	resp, err := rs.client.Requester.PostJSON(rs.basePath, bytes.NewReader(payload), result, params)
+	resp, err := rs.client.Requester.PostJSONContext(ctx, rs.basePath, bytes.NewReader(payload), result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -79,13 +104,17 @@ func (rs *AWXResourceService[T]) Create(data map[string]interface{}, params map[
 }
 
 func (rs *AWXResourceService[T]) Update(id int, data map[string]interface{}, params map[string]string) (*T, error) {
+	return rs.UpdateContext(context.Background(), id, data, params)
+}
+
+func (rs *AWXResourceService[T]) UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*T, error) {
 	result := new(T)
 	endpoint := fmt.Sprintf("%s%d", rs.basePath, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := rs.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := rs.client.Requester.PatchJSONContext(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -98,10 +127,14 @@ func (rs *AWXResourceService[T]) Update(id int, data map[string]interface{}, par
 }
 
 func (rs *AWXResourceService[T]) Delete(id int) (*T, error) {
+	return rs.DeleteContext(context.Background(), id)
+}
+
+func (rs *AWXResourceService[T]) DeleteContext(ctx context.Context, id int) (*T, error) {
 	result := new(T)
 	endpoint := fmt.Sprintf("%s%d", rs.basePath, id)
 
-	resp, err := rs.client.Requester.Delete(endpoint, result, nil)
+	resp, err := rs.client.Requester.DeleteContext(ctx, endpoint, result, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,37 @@
 package awx
 
+import "context"
+
 type GroupService interface {
 	List(params map[string]string) ([]*Group, *ResultsList[Group], error)
 	GetByID(id int, params map[string]string) (*Group, error)
 	Create(data map[string]interface{}, params map[string]string) (*Group, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Group, error)
 	Delete(id int) (*Group, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Group, *ResultsList[Group], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Group, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Group, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Group, error)
+	DeleteContext(ctx context.Context, id int) (*Group, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Group, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Group]
+	ListQuery(ctx context.Context, q *Query) ([]*Group, *ResultsList[Group], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Group, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Group]
+	CreateWith(ctx context.Context, req CreateRequest[Group], params map[string]string) (*Group, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Group], params map[string]string) (*Group, error)
+	GetByName(name string, scope map[string]string) (*Group, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Group, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Group, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Group, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Group]) (*EnsureResult[Group], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Group, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type groupServiceHTTP struct {
@@ -20,3 +46,38 @@ type ListGroupsResponse struct {
 }
 
 const groupsAPIEndpoint = "/api/v2/groups/"
+
+// GroupCreateRequest holds the fields of a new group, created in the given inventory.
+type GroupCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Inventory   int    `json:"inventory"`
+	Variables   string `json:"variables,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *GroupCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("inventory", r.Inventory != 0),
+	)
+}
+
+func (*GroupCreateRequest) createRequest(*Group) {}
+
+// GroupUpdateRequest renames a group or changes its variables, the nil fields are left as they are.
+type GroupUpdateRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Inventory   *int    `json:"inventory,omitempty"`
+	Variables   *string `json:"variables,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *GroupUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+	)
+}
+
+func (*GroupUpdateRequest) updateRequest(*Group) {}
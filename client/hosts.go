@@ -1,10 +1,6 @@
 package awx
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-)
+import "context"
 
 type HostService interface {
 	List(params map[string]string) ([]*Host, *ResultsList[Host], error)
@@ -12,8 +8,35 @@ type HostService interface {
 	Create(data map[string]interface{}, params map[string]string) (*Host, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Host, error)
 	Delete(id int) (*Host, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Host, *ResultsList[Host], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Host, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Host, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Host, error)
+	DeleteContext(ctx context.Context, id int) (*Host, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Host, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Host]
+	ListQuery(ctx context.Context, q *Query) ([]*Host, *ResultsList[Host], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Host, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Host]
+	CreateWith(ctx context.Context, req CreateRequest[Host], params map[string]string) (*Host, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Host], params map[string]string) (*Host, error)
+	GetByName(name string, scope map[string]string) (*Host, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Host, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Host, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Host, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Host]) (*EnsureResult[Host], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Host, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 	AssociateGroup(id int, data map[string]interface{}, params map[string]string) (*Host, error)
+	AssociateGroupContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Host, error)
 	DisAssociateGroup(id int, data map[string]interface{}, params map[string]string) (*Host, error)
+	DisAssociateGroupContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Host, error)
+	GroupsAssociation() *Association[Host, Group]
 }
 
 type hostServiceHTTP struct {
@@ -35,27 +58,55 @@ type ListHostsResponse struct {
 
 const hostsAPIEndpoint = "/api/v2/hosts/"
 
+// HostCreateRequest holds the fields of a new host, created in the given inventory.
+type HostCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Inventory   int    `json:"inventory"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	InstanceID  string `json:"instance_id,omitempty"`
+	Variables   string `json:"variables,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *HostCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("inventory", r.Inventory != 0),
+	)
+}
+
+func (*HostCreateRequest) createRequest(*Host) {}
+
+// HostUpdateRequest changes some fields of a host, e.g. disables it, the nil ones are not sent.
+type HostUpdateRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Inventory   *int    `json:"inventory,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	InstanceID  *string `json:"instance_id,omitempty"`
+	Variables   *string `json:"variables,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *HostUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+	)
+}
+
+func (*HostUpdateRequest) updateRequest(*Host) {}
+
 // AssociateGroup update an awx Host
 func (h *hostServiceHTTP) AssociateGroup(id int, data map[string]interface{}, params map[string]string) (*Host, error) {
+	return h.AssociateGroupContext(context.Background(), id, data, params)
+}
+
+// AssociateGroupContext is the context-aware version of AssociateGroup.
+func (h *hostServiceHTTP) AssociateGroupContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Host, error) {
 	result := new(Host)
-	endpoint := fmt.Sprintf("%s%d/groups/", hostsAPIEndpoint, id)
 	data["associate"] = true
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := h.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	if err := h.GroupsAssociation().send(ctx, id, data, result); err != nil {
 		return nil, err
 	}
 
@@ -64,27 +115,21 @@ func (h *hostServiceHTTP) AssociateGroup(id int, data map[string]interface{}, pa
 
 // DisAssociateGroup update an awx Host
 func (h *hostServiceHTTP) DisAssociateGroup(id int, data map[string]interface{}, params map[string]string) (*Host, error) {
+	return h.DisAssociateGroupContext(context.Background(), id, data, params)
+}
+
+// DisAssociateGroupContext is the context-aware version of DisAssociateGroup.
+func (h *hostServiceHTTP) DisAssociateGroupContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Host, error) {
 	result := new(Host)
-	endpoint := fmt.Sprintf("%s%d/groups/", hostsAPIEndpoint, id)
 	data["disassociate"] = true
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := h.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	if err := h.GroupsAssociation().send(ctx, id, data, result); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
+
+// GroupsAssociation returns the Association of the groups of the hosts.
+func (h *hostServiceHTTP) GroupsAssociation() *Association[Host, Group] {
+	return NewAssociation[Host, Group](h.client, "groups")
+}
@@ -1,5 +1,7 @@
 package awx
 
+import "context"
+
 // InstanceGroupsService implements awx execution environments apis.
 type InstanceGroupService interface {
 	List(params map[string]string) ([]*InstanceGroup, *ResultsList[InstanceGroup], error)
@@ -7,6 +9,30 @@ type InstanceGroupService interface {
 	Create(data map[string]interface{}, params map[string]string) (*InstanceGroup, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*InstanceGroup, error)
 	Delete(id int) (*InstanceGroup, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*InstanceGroup, *ResultsList[InstanceGroup], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*InstanceGroup, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*InstanceGroup, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*InstanceGroup, error)
+	DeleteContext(ctx context.Context, id int) (*InstanceGroup, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*InstanceGroup, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[InstanceGroup]
+	ListQuery(ctx context.Context, q *Query) ([]*InstanceGroup, *ResultsList[InstanceGroup], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*InstanceGroup, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[InstanceGroup]
+	CreateWith(ctx context.Context, req CreateRequest[InstanceGroup], params map[string]string) (*InstanceGroup, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[InstanceGroup], params map[string]string) (*InstanceGroup, error)
+	GetByName(name string, scope map[string]string) (*InstanceGroup, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*InstanceGroup, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*InstanceGroup, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*InstanceGroup, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[InstanceGroup]) (*EnsureResult[InstanceGroup], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*InstanceGroup, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type instanceGroupServiceHTTP struct {
@@ -21,3 +47,44 @@ type ListInstanceGroupsResponse struct {
 }
 
 const InstanceGroupsAPIEndpoint = "/api/v2/instance_groups/"
+
+// InstanceGroupCreateRequest holds the fields of a new instance group, or of a container group
+// when IsContainerGroup is set.
+type InstanceGroupCreateRequest struct {
+	Name                     string `json:"name"`
+	IsContainerGroup         *bool  `json:"is_container_group,omitempty"`
+	Credential               int    `json:"credential,omitempty"`
+	PodSpecOverride          string `json:"pod_spec_override,omitempty"`
+	PolicyInstancePercentage int    `json:"policy_instance_percentage,omitempty"`
+	PolicyInstanceMinimum    int    `json:"policy_instance_minimum,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *InstanceGroupCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		between("policy_instance_percentage", &r.PolicyInstancePercentage, 0, 100),
+	)
+}
+
+func (*InstanceGroupCreateRequest) createRequest(*InstanceGroup) {}
+
+// InstanceGroupUpdateRequest changes the policy or the capacity settings of an instance group.
+type InstanceGroupUpdateRequest struct {
+	Name                     *string `json:"name,omitempty"`
+	IsContainerGroup         *bool   `json:"is_container_group,omitempty"`
+	Credential               *int    `json:"credential,omitempty"`
+	PodSpecOverride          *string `json:"pod_spec_override,omitempty"`
+	PolicyInstancePercentage *int    `json:"policy_instance_percentage,omitempty"`
+	PolicyInstanceMinimum    *int    `json:"policy_instance_minimum,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *InstanceGroupUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		between("policy_instance_percentage", r.PolicyInstancePercentage, 0, 100),
+	)
+}
+
+func (*InstanceGroupUpdateRequest) updateRequest(*InstanceGroup) {}
@@ -1,6 +1,10 @@
 package awx
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
 
 // InventoriesService implements awx inventories apis.
 type InventoryService interface {
@@ -9,7 +13,37 @@ type InventoryService interface {
 	Create(data map[string]interface{}, params map[string]string) (*Inventory, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Inventory, error)
 	Delete(id int) (*Inventory, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Inventory, *ResultsList[Inventory], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Inventory, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Inventory, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Inventory, error)
+	DeleteContext(ctx context.Context, id int) (*Inventory, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Inventory, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Inventory]
+	ListQuery(ctx context.Context, q *Query) ([]*Inventory, *ResultsList[Inventory], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Inventory, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Inventory]
+	CreateWith(ctx context.Context, req CreateRequest[Inventory], params map[string]string) (*Inventory, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Inventory], params map[string]string) (*Inventory, error)
+	GetByName(name string, scope map[string]string) (*Inventory, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Inventory, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Inventory, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Inventory, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Inventory]) (*EnsureResult[Inventory], error)
+	Copy(id int, newName string) (*Inventory, error)
+	CopyContext(ctx context.Context, id int, newName string) (*Inventory, error)
+	CanCopy(id int) (*CopyCapability, error)
+	CanCopyContext(ctx context.Context, id int) (*CopyCapability, error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Inventory, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 	ListInventoryGroups(id int, params map[string]string) ([]*Group, *ListGroupsResponse, error)
+	ListInventoryGroupsContext(ctx context.Context, id int, params map[string]string) ([]*Group, *ListGroupsResponse, error)
+	ListInventoryGroupsQuery(ctx context.Context, id int, q *Query) ([]*Group, *ListGroupsResponse, error)
 }
 
 type inventoryServiceHTTP struct {
@@ -25,10 +59,66 @@ type ListInventoriesResponse struct {
 
 const inventoriesAPIEndpoint = "/api/v2/inventories/"
 
+// InventoryCreateRequest holds the fields of a new inventory. A smart inventory needs a host filter.
+type InventoryCreateRequest struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Organization int    `json:"organization"`
+	Kind         string `json:"kind,omitempty"`
+	HostFilter   string `json:"host_filter,omitempty"`
+	Variables    string `json:"variables,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *InventoryCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("organization", r.Organization != 0),
+		oneOf("kind", r.Kind, "smart", "constructed"),
+		required("host_filter", r.Kind != "smart" || r.HostFilter != ""),
+	)
+}
+
+func (*InventoryCreateRequest) createRequest(*Inventory) {}
+
+// InventoryUpdateRequest changes some fields of an inventory, only the fields set are sent.
+type InventoryUpdateRequest struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Organization *int    `json:"organization,omitempty"`
+	Kind         *string `json:"kind,omitempty"`
+	HostFilter   *string `json:"host_filter,omitempty"`
+	Variables    *string `json:"variables,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *InventoryUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		oneOf("kind", deref(r.Kind), "smart", "constructed"),
+	)
+}
+
+func (*InventoryUpdateRequest) updateRequest(*Inventory) {}
+
 func (i *inventoryServiceHTTP) ListInventoryGroups(id int, params map[string]string) ([]*Group, *ListGroupsResponse, error) {
+	return i.ListInventoryGroupsContext(context.Background(), id, params)
+}
+
+// ListInventoryGroupsContext is the context-aware version of ListInventoryGroups.
+func (i *inventoryServiceHTTP) ListInventoryGroupsContext(ctx context.Context, id int, params map[string]string) ([]*Group, *ListGroupsResponse, error) {
+	return i.listInventoryGroups(ctx, id, mapValues(params))
+}
+
+// ListInventoryGroupsQuery lists the groups of an inventory matching q.
+func (i *inventoryServiceHTTP) ListInventoryGroupsQuery(ctx context.Context, id int, q *Query) ([]*Group, *ListGroupsResponse, error) {
+	return i.listInventoryGroups(ctx, id, q.Values())
+}
+
+func (i *inventoryServiceHTTP) listInventoryGroups(ctx context.Context, id int, query url.Values) ([]*Group, *ListGroupsResponse, error) {
 	result := new(ListGroupsResponse)
 	endpoint := fmt.Sprintf("%s%d/groups/", inventoriesAPIEndpoint, id)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := i.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -1,6 +1,7 @@
 package awx
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -11,8 +12,33 @@ type InventorySourceService interface {
 	Create(data map[string]interface{}, params map[string]string) (*InventorySource, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*InventorySource, error)
 	Delete(id int) (*InventorySource, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*InventorySource, *ResultsList[InventorySource], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*InventorySource, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*InventorySource, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*InventorySource, error)
+	DeleteContext(ctx context.Context, id int) (*InventorySource, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*InventorySource, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[InventorySource]
+	ListQuery(ctx context.Context, q *Query) ([]*InventorySource, *ResultsList[InventorySource], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*InventorySource, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[InventorySource]
+	CreateWith(ctx context.Context, req CreateRequest[InventorySource], params map[string]string) (*InventorySource, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[InventorySource], params map[string]string) (*InventorySource, error)
+	GetByName(name string, scope map[string]string) (*InventorySource, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*InventorySource, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*InventorySource, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*InventorySource, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[InventorySource]) (*EnsureResult[InventorySource], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*InventorySource, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 
 	GetInventorySource(id int, params map[string]string) (*InventorySource, error)
+	GetInventorySourceContext(ctx context.Context, id int, params map[string]string) (*InventorySource, error)
 }
 
 type inventorySourceServiceHTTP struct {
@@ -28,11 +54,85 @@ type ListInventorySourcesResponse struct {
 
 const inventorySourcesAPIEndpoint = "/api/v2/inventory_sources/"
 
+// InventorySourceCreateRequest holds the fields of a new inventory source. An scm source needs
+// the project holding the inventory file.
+type InventorySourceCreateRequest struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description,omitempty"`
+	Inventory            int    `json:"inventory"`
+	Source               string `json:"source"`
+	SourcePath           string `json:"source_path,omitempty"`
+	SourceProject        int    `json:"source_project,omitempty"`
+	SourceVars           string `json:"source_vars,omitempty"`
+	Credential           int    `json:"credential,omitempty"`
+	ExecutionEnvironment int    `json:"execution_environment,omitempty"`
+	EnabledVar           string `json:"enabled_var,omitempty"`
+	EnabledValue         string `json:"enabled_value,omitempty"`
+	HostFilter           string `json:"host_filter,omitempty"`
+	Overwrite            *bool  `json:"overwrite,omitempty"`
+	OverwriteVars        *bool  `json:"overwrite_vars,omitempty"`
+	UpdateOnLaunch       *bool  `json:"update_on_launch,omitempty"`
+	UpdateCacheTimeout   int    `json:"update_cache_timeout,omitempty"`
+	Timeout              int    `json:"timeout,omitempty"`
+	Verbosity            int    `json:"verbosity,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *InventorySourceCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("inventory", r.Inventory != 0),
+		required("source", r.Source != ""),
+		required("source_project", r.Source != "scm" || r.SourceProject != 0),
+		between("verbosity", &r.Verbosity, 0, 2),
+	)
+}
+
+func (*InventorySourceCreateRequest) createRequest(*InventorySource) {}
+
+// InventorySourceUpdateRequest changes the source or the update settings of an inventory source.
+type InventorySourceUpdateRequest struct {
+	Name                 *string `json:"name,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	Inventory            *int    `json:"inventory,omitempty"`
+	Source               *string `json:"source,omitempty"`
+	SourcePath           *string `json:"source_path,omitempty"`
+	SourceProject        *int    `json:"source_project,omitempty"`
+	SourceVars           *string `json:"source_vars,omitempty"`
+	Credential           *int    `json:"credential,omitempty"`
+	ExecutionEnvironment *int    `json:"execution_environment,omitempty"`
+	EnabledVar           *string `json:"enabled_var,omitempty"`
+	EnabledValue         *string `json:"enabled_value,omitempty"`
+	HostFilter           *string `json:"host_filter,omitempty"`
+	Overwrite            *bool   `json:"overwrite,omitempty"`
+	OverwriteVars        *bool   `json:"overwrite_vars,omitempty"`
+	UpdateOnLaunch       *bool   `json:"update_on_launch,omitempty"`
+	UpdateCacheTimeout   *int    `json:"update_cache_timeout,omitempty"`
+	Timeout              *int    `json:"timeout,omitempty"`
+	Verbosity            *int    `json:"verbosity,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *InventorySourceUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		notEmpty("source", r.Source),
+		between("verbosity", r.Verbosity, 0, 2),
+	)
+}
+
+func (*InventorySourceUpdateRequest) updateRequest(*InventorySource) {}
+
 // GetInventorySource retrives the InventorySource information from its ID or Name
 func (i *inventorySourceServiceHTTP) GetInventorySource(id int, params map[string]string) (*InventorySource, error) {
+	return i.GetInventorySourceContext(context.Background(), id, params)
+}
+
+// GetInventorySourceContext is the context-aware version of GetInventorySource.
+func (i *inventorySourceServiceHTTP) GetInventorySourceContext(ctx context.Context, id int, params map[string]string) (*InventorySource, error) {
 	endpoint := fmt.Sprintf("%s%d", inventorySourcesAPIEndpoint, id)
 	result := new(InventorySource)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, map[string]string{})
+	resp, err := i.client.Requester.GetJSONContext(ctx, endpoint, result, map[string]string{})
 	if err != nil {
 		return nil, err
 	}
@@ -2,8 +2,11 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
 )
 
 // Enum of job statuses.
@@ -18,13 +21,32 @@ const (
 	JobStatusCanceled   = "canceled"
 )
 
+// Types of the jobs reported by JobFailedError.
+const (
+	JobTypeJob         = "job"
+	JobTypeWorkflowJob = "workflow_job"
+)
+
 // JobService implements awx job apis.
 type JobService interface {
 	GetJob(id int, params map[string]string) (*Job, error)
+	GetJobContext(ctx context.Context, id int, params map[string]string) (*Job, error)
 	CancelJob(id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error)
+	CancelJobContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error)
 	RelaunchJob(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error)
+	RelaunchJobContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error)
 	GetHostSummaries(id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error)
+	GetHostSummariesContext(ctx context.Context, id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error)
+	GetHostSummariesQuery(ctx context.Context, id int, q *Query) ([]HostSummary, *HostSummariesResponse, error)
 	GetJobEvents(id int, params map[string]string) ([]JobEvent, *JobEventsResponse, error)
+	GetJobEventsContext(ctx context.Context, id int, params map[string]string) ([]JobEvent, *JobEventsResponse, error)
+	GetJobEventsQuery(ctx context.Context, id int, q *Query) ([]JobEvent, *JobEventsResponse, error)
+	Wait(ctx context.Context, id int, opts *WaitOptions) (*Job, error)
+	Stdout(ctx context.Context, id int, format StdoutFormat, opts *StdoutOptions) (string, error)
+	StdoutLines(ctx context.Context, id int, opts *StdoutOptions) (*JobStdout, error)
+	DownloadStdout(ctx context.Context, id int, format StdoutFormat) (io.ReadCloser, error)
+	Follow(ctx context.Context, id int, w io.Writer, opts *FollowOptions) error
+	Events(ctx context.Context, id int, opts *JobEventOptions) *JobEventIterator
 }
 
 type jobServiceHTTP struct {
@@ -52,9 +74,14 @@ const jobAPIEndpoint = "/api/v2/jobs/"
 
 // GetJob shows the details of a job.
 func (j *jobServiceHTTP) GetJob(id int, params map[string]string) (*Job, error) {
+	return j.GetJobContext(context.Background(), id, params)
+}
+
+// GetJobContext is the context-aware version of GetJob.
+func (j *jobServiceHTTP) GetJobContext(ctx context.Context, id int, params map[string]string) (*Job, error) {
 	result := new(Job)
 	endpoint := fmt.Sprintf("%s%d/", jobAPIEndpoint, id)
-	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := j.client.Requester.GetJSONContext(ctx, endpoint, result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +95,11 @@ func (j *jobServiceHTTP) GetJob(id int, params map[string]string) (*Job, error)
 
 // CancelJob cancels a job.
 func (j *jobServiceHTTP) CancelJob(id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error) {
+	return j.CancelJobContext(context.Background(), id, data, params)
+}
+
+// CancelJobContext is the context-aware version of CancelJob.
+func (j *jobServiceHTTP) CancelJobContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error) {
 	result := new(CancelJobResponse)
 	endpoint := fmt.Sprintf("%s%d/cancel/", jobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -75,7 +107,7 @@ func (j *jobServiceHTTP) CancelJob(id int, data map[string]interface{}, params m
 		return nil, err
 	}
 
-	resp, err := j.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := j.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +121,11 @@ func (j *jobServiceHTTP) CancelJob(id int, data map[string]interface{}, params m
 
 // RelaunchJob relaunch a job.
 func (j *jobServiceHTTP) RelaunchJob(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+	return j.RelaunchJobContext(context.Background(), id, data, params)
+}
+
+// RelaunchJobContext is the context-aware version of RelaunchJob.
+func (j *jobServiceHTTP) RelaunchJobContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/relaunch/", jobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -96,7 +133,7 @@ func (j *jobServiceHTTP) RelaunchJob(id int, data map[string]interface{}, params
 		return nil, err
 	}
 
-	resp, err := j.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := j.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -110,9 +147,23 @@ func (j *jobServiceHTTP) RelaunchJob(id int, data map[string]interface{}, params
 
 // GetHostSummaries get a job hosts summaries.
 func (j *jobServiceHTTP) GetHostSummaries(id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error) {
+	return j.GetHostSummariesContext(context.Background(), id, params)
+}
+
+// GetHostSummariesContext is the context-aware version of GetHostSummaries.
+func (j *jobServiceHTTP) GetHostSummariesContext(ctx context.Context, id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error) {
+	return j.getHostSummaries(ctx, id, mapValues(params))
+}
+
+// GetHostSummariesQuery gets the host summaries of a job matching q.
+func (j *jobServiceHTTP) GetHostSummariesQuery(ctx context.Context, id int, q *Query) ([]HostSummary, *HostSummariesResponse, error) {
+	return j.getHostSummaries(ctx, id, q.Values())
+}
+
+func (j *jobServiceHTTP) getHostSummaries(ctx context.Context, id int, query url.Values) ([]HostSummary, *HostSummariesResponse, error) {
 	result := new(HostSummariesResponse)
 	endpoint := fmt.Sprintf("%s%d/job_host_summaries/", jobAPIEndpoint, id)
-	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := j.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -126,9 +177,23 @@ func (j *jobServiceHTTP) GetHostSummaries(id int, params map[string]string) ([]H
 
 // GetJobEvents get a list of job events.
 func (j *jobServiceHTTP) GetJobEvents(id int, params map[string]string) ([]JobEvent, *JobEventsResponse, error) {
+	return j.GetJobEventsContext(context.Background(), id, params)
+}
+
+// GetJobEventsContext is the context-aware version of GetJobEvents.
+func (j *jobServiceHTTP) GetJobEventsContext(ctx context.Context, id int, params map[string]string) ([]JobEvent, *JobEventsResponse, error) {
+	return j.getJobEvents(ctx, id, mapValues(params))
+}
+
+// GetJobEventsQuery gets the first page of the events of a job matching q.
+func (j *jobServiceHTTP) GetJobEventsQuery(ctx context.Context, id int, q *Query) ([]JobEvent, *JobEventsResponse, error) {
+	return j.getJobEvents(ctx, id, q.Values())
+}
+
+func (j *jobServiceHTTP) getJobEvents(ctx context.Context, id int, query url.Values) ([]JobEvent, *JobEventsResponse, error) {
 	result := new(JobEventsResponse)
 	endpoint := fmt.Sprintf("%s%d/job_events/", jobAPIEndpoint, id)
-	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := j.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -0,0 +1,219 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Types of the job events, see JobEvent.Event.
+const (
+	JobEventPlaybookOnStart       = "playbook_on_start"
+	JobEventPlaybookOnPlayStart   = "playbook_on_play_start"
+	JobEventPlaybookOnTaskStart   = "playbook_on_task_start"
+	JobEventPlaybookOnStats       = "playbook_on_stats"
+	JobEventRunnerOnStart         = "runner_on_start"
+	JobEventRunnerOnOK            = "runner_on_ok"
+	JobEventRunnerOnFailed        = "runner_on_failed"
+	JobEventRunnerOnSkipped       = "runner_on_skipped"
+	JobEventRunnerOnUnreachable   = "runner_on_unreachable"
+	JobEventRunnerItemOnOK        = "runner_item_on_ok"
+	JobEventRunnerItemOnFailed    = "runner_item_on_failed"
+	JobEventRunnerItemOnSkipped   = "runner_item_on_skipped"
+	JobEventRunnerRetry           = "runner_retry"
+	JobEventVerbose               = "verbose"
+	JobEventPlaybookOnNoHostsLeft = "playbook_on_no_hosts_remaining"
+)
+
+// NullID is an object id which may be null, e.g. the host of a job event not related to a host.
+type NullID struct {
+	ID int
+	// Valid is false when the id is null.
+	Valid bool
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NullID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = NullID{}
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.ID); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n NullID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.ID)
+}
+
+// JobEventOptions selects the events returned by JobService.Events, the zero fields do not filter.
+type JobEventOptions struct {
+	// Events keeps the events of these types, e.g. JobEventRunnerOnFailed.
+	Events []string
+	// Host keeps the events of a host, given by id.
+	Host int
+	// HostName keeps the events of a host, given by name.
+	HostName string
+	// Task keeps the events of a task, given by name.
+	Task    string
+	Changed *bool
+	Failed  *bool
+	// After resumes the iteration after the event with this counter, see JobEventIterator.Counter.
+	After int
+	// Tail keeps waiting for new events until the job finishes.
+	Tail bool
+	// Interval is the delay between two polls in tail mode, DefaultWaitInterval when zero.
+	Interval time.Duration
+}
+
+// query returns the filters of the events, sorted by counter.
+func (o *JobEventOptions) query() *Query {
+	q := NewQuery().OrderBy("counter").PageSize(MaxPageSize)
+	if len(o.Events) > 0 {
+		q.In("event", o.Events...)
+	}
+	if o.Host != 0 {
+		q.Eq("host", strconv.Itoa(o.Host))
+	}
+	if o.HostName != "" {
+		q.Eq("host_name", o.HostName)
+	}
+	if o.Task != "" {
+		q.Eq("task", o.Task)
+	}
+	if o.Changed != nil {
+		q.Eq("changed", strconv.FormatBool(*o.Changed))
+	}
+	if o.Failed != nil {
+		q.Eq("failed", strconv.FormatBool(*o.Failed))
+	}
+	return q
+}
+
+// JobEventIterator iterates over the events of a job in the order of their counter.
+// Each page is requested after the counter of the last event, the iteration can be resumed
+// later on from Counter with JobEventOptions.After.
+//
+//	events := client.JobService.Events(ctx, jobID, &awx.JobEventOptions{Tail: true})
+//	for events.Next() {
+//		event := events.Value()
+//	}
+//	if err := events.Err(); err != nil {
+//		return err
+//	}
+type JobEventIterator struct {
+	ctx  context.Context
+	jobs *jobServiceHTTP
+	id   int
+	opts JobEventOptions
+
+	page     []*JobEvent
+	current  *JobEvent
+	counter  int
+	lastPage bool
+	jobDone  bool
+	err      error
+}
+
+// Events returns an iterator over the events of a job matching opts, all of them when opts is nil.
+func (j *jobServiceHTTP) Events(ctx context.Context, id int, opts *JobEventOptions) *JobEventIterator {
+	if opts == nil {
+		opts = &JobEventOptions{}
+	}
+	return &JobEventIterator{
+		ctx:     ctx,
+		jobs:    j,
+		id:      id,
+		opts:    *opts,
+		counter: opts.After,
+	}
+}
+
+// Next advances to the next event, fetching the next page if needed. In tail mode, it waits for
+// new events until the job finishes. It returns false when there are no more events or an error occurred.
+func (it *JobEventIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.err != nil || it.lastPage {
+			it.current = nil
+			return false
+		}
+		it.err = it.fetch()
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+	it.counter = it.current.Counter
+	return true
+}
+
+// Value returns the current event.
+func (it *JobEventIterator) Value() *JobEvent {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *JobEventIterator) Err() error {
+	return it.err
+}
+
+// Counter returns the counter of the last event returned by Next, or JobEventOptions.After before the first one.
+func (it *JobEventIterator) Counter() int {
+	return it.counter
+}
+
+// fetch gets the events following the last one, in tail mode it waits for them while the job runs.
+func (it *JobEventIterator) fetch() error {
+	q := it.opts.query().Filter("counter", GT, strconv.Itoa(it.counter))
+
+	result := new(ResultsList[JobEvent])
+	endpoint := fmt.Sprintf("%s%d/job_events/", jobAPIEndpoint, it.id)
+	resp, err := it.jobs.client.Requester.getJSONQueryContext(it.ctx, endpoint, result, q.Values())
+	if err != nil {
+		return err
+	}
+	if err := CheckResponse(resp); err != nil {
+		return err
+	}
+
+	it.page = result.Results
+	if next, _ := result.Next.(string); next != "" {
+		return nil
+	}
+	if !it.opts.Tail {
+		it.lastPage = true
+		return nil
+	}
+	if len(it.page) > 0 {
+		return nil
+	}
+	if it.jobDone {
+		it.lastPage = true
+		return nil
+	}
+
+	// no new event: either the job is done and one last page is fetched, or it is polled again later
+	job, err := it.jobs.GetJobContext(it.ctx, it.id, nil)
+	if err != nil {
+		return err
+	}
+	if IsJobFinished(job.Status) && job.EventProcessingFinished {
+		it.jobDone = true
+		return nil
+	}
+
+	interval := it.opts.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	return sleepContext(it.ctx, interval)
+}
@@ -0,0 +1,150 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNullID(t *testing.T) {
+	var event JobEvent
+	if err := json.Unmarshal([]byte(`{"host": null}`), &event); err != nil {
+		t.Fatalf("Unmarshal err: %s", err)
+	}
+	if event.Host.Valid {
+		t.Fatalf("Expecting a null host but got %+v", event.Host)
+	}
+
+	if err := json.Unmarshal([]byte(`{"host": 12}`), &event); err != nil {
+		t.Fatalf("Unmarshal err: %s", err)
+	}
+	if event.Host != (NullID{ID: 12, Valid: true}) {
+		t.Fatalf("Expecting the host 12 but got %+v", event.Host)
+	}
+
+	data, _ := json.Marshal(map[string]NullID{"null": {}, "host": event.Host})
+	if string(data) != `{"host":12,"null":null}` {
+		t.Fatalf("Expecting the null id to be encoded as null but got %s", data)
+	}
+}
+
+// newEventsServer serves the events of the job 42, which emits one more event on each poll of the job
+// until it has emitted counters 1 to total.
+func newEventsServer(t *testing.T, total int, onQuery func(query map[string]string)) *testServer {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		emitted = 1
+	)
+	return newTestServer(t, testRoutes{
+		"/api/v2/jobs/42/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if emitted < total {
+				emitted++
+			}
+			status := JobStatusRunning
+			if emitted == total {
+				status = JobStatusSuccessful
+			}
+			fmt.Fprintf(w, `{"id": 42, "status": %q, "event_processing_finished": %t}`, status, emitted == total)
+		},
+		"/api/v2/jobs/42/job_events/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			query := map[string]string{}
+			for key := range r.URL.Query() {
+				query[key] = r.URL.Query().Get(key)
+			}
+			if onQuery != nil {
+				onQuery(query)
+			}
+
+			after, _ := strconv.Atoi(query["counter__gt"])
+			// pages of two events
+			results := []map[string]interface{}{}
+			for counter := after + 1; counter <= emitted && len(results) < 2; counter++ {
+				results = append(results, map[string]interface{}{"counter": counter, "event": JobEventRunnerOnOK, "host": nil})
+			}
+			var next interface{}
+			if after+2 < emitted {
+				next = fmt.Sprintf("/api/v2/jobs/42/job_events/?counter__gt=%d&page=2", after)
+			}
+			writeJSON(w, map[string]interface{}{"count": emitted - after, "next": next, "results": results})
+		},
+	})
+}
+
+func eventCounters(t *testing.T, it *JobEventIterator) []int {
+	t.Helper()
+
+	counters := []int{}
+	for it.Next() {
+		counters = append(counters, it.Value().Counter)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Events err: %s", err)
+	}
+	return counters
+}
+
+func TestJobEvents(t *testing.T) {
+	var lastQuery map[string]string
+	server := newEventsServer(t, 5, func(query map[string]string) { lastQuery = query })
+	jobs := &jobServiceHTTP{client: server.awxClient()}
+	ctx := context.Background()
+
+	// let the job emit its five events
+	for i := 0; i < 5; i++ {
+		jobs.GetJob(42, nil)
+	}
+
+	failed := true
+	it := jobs.Events(ctx, 42, &JobEventOptions{
+		Events:   []string{JobEventRunnerOnFailed, JobEventRunnerOnUnreachable},
+		HostName: "web-01",
+		Failed:   &failed,
+	})
+	if counters := eventCounters(t, it); !reflect.DeepEqual(counters, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Expecting counters 1 to 5 but got %v", counters)
+	}
+	if it.Counter() != 5 {
+		t.Fatalf("Expecting the counter 5 but got %d", it.Counter())
+	}
+
+	wantQuery := map[string]string{
+		"event__in":   "runner_on_failed,runner_on_unreachable",
+		"host_name":   "web-01",
+		"failed":      "true",
+		"counter__gt": "4",
+		"order_by":    "counter",
+		"page_size":   "200",
+	}
+	if !reflect.DeepEqual(lastQuery, wantQuery) {
+		t.Fatalf("Expecting the query %v but got %v", wantQuery, lastQuery)
+	}
+
+	// resume after the third event
+	if counters := eventCounters(t, jobs.Events(ctx, 42, &JobEventOptions{After: 3})); !reflect.DeepEqual(counters, []int{4, 5}) {
+		t.Fatalf("Expecting counters 4 and 5 but got %v", counters)
+	}
+}
+
+func TestJobEventsTail(t *testing.T) {
+	server := newEventsServer(t, 4, nil)
+	jobs := &jobServiceHTTP{client: server.awxClient()}
+
+	it := jobs.Events(context.Background(), 42, &JobEventOptions{Tail: true, Interval: time.Millisecond})
+	if counters := eventCounters(t, it); !reflect.DeepEqual(counters, []int{1, 2, 3, 4}) {
+		t.Fatalf("Expecting counters 1 to 4 but got %v", counters)
+	}
+}
@@ -0,0 +1,148 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// StdoutFormat is a format of the job output served by the awx `stdout` endpoint.
+type StdoutFormat string
+
+// Formats of the job output.
+const (
+	StdoutText StdoutFormat = "txt"
+	StdoutANSI StdoutFormat = "ansi"
+	StdoutJSON StdoutFormat = "json"
+	StdoutHTML StdoutFormat = "html"
+)
+
+// StdoutOptions selects the lines of the job output, from StartLine included to EndLine excluded.
+// A zero EndLine reads up to the last line.
+type StdoutOptions struct {
+	StartLine int
+	EndLine   int
+}
+
+// FollowOptions configures Follow.
+type FollowOptions struct {
+	// Interval is the delay between two polls for new output, DefaultWaitInterval when zero.
+	Interval time.Duration
+}
+
+// JobStdout is a range of lines of the job output.
+type JobStdout struct {
+	Range struct {
+		Start int `json:"start"`
+		// End is the line following the last returned one, the start of the next range.
+		End int `json:"end"`
+		// AbsoluteEnd is the number of lines of the whole output.
+		AbsoluteEnd int `json:"absolute_end"`
+	} `json:"range"`
+	// Content keeps the ANSI escape sequences of the output.
+	Content string `json:"content"`
+}
+
+// ansiEscape matches the ANSI escape sequences coloring the output.
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// query returns the query parameters selecting the lines in format.
+func (o *StdoutOptions) query(format string) url.Values {
+	query := url.Values{"format": {format}}
+	if o == nil {
+		return query
+	}
+	if o.StartLine > 0 {
+		query.Set("start_line", strconv.Itoa(o.StartLine))
+	}
+	if o.EndLine > 0 {
+		query.Set("end_line", strconv.Itoa(o.EndLine))
+	}
+	return query
+}
+
+// Stdout returns the output of a job in format, the lines selected by opts or the whole output when nil.
+func (j *jobServiceHTTP) Stdout(ctx context.Context, id int, format StdoutFormat, opts *StdoutOptions) (string, error) {
+	var result string
+	endpoint := fmt.Sprintf("%s%d/stdout/", jobAPIEndpoint, id)
+	resp, err := j.client.Requester.DoContext(ctx, NewAPIRequest("GET", endpoint, nil), &result, opts.query(string(format)))
+	if err != nil {
+		return "", err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+// StdoutLines returns the lines of the job output selected by opts along with their range,
+// the whole output when opts is nil.
+func (j *jobServiceHTTP) StdoutLines(ctx context.Context, id int, opts *StdoutOptions) (*JobStdout, error) {
+	result := new(JobStdout)
+	endpoint := fmt.Sprintf("%s%d/stdout/", jobAPIEndpoint, id)
+	resp, err := j.client.Requester.getJSONQueryContext(ctx, endpoint, result, opts.query(string(StdoutJSON)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DownloadStdout streams the whole output of a job in format, either StdoutText or StdoutANSI,
+// without loading it in memory. The caller must close the returned reader.
+func (j *jobServiceHTTP) DownloadStdout(ctx context.Context, id int, format StdoutFormat) (io.ReadCloser, error) {
+	if format != StdoutText && format != StdoutANSI {
+		return nil, fmt.Errorf("awx: the %s output format cannot be downloaded", format)
+	}
+
+	endpoint := fmt.Sprintf("%s%d/stdout/", jobAPIEndpoint, id)
+	return j.client.Requester.openContext(ctx, endpoint, url.Values{"format": {string(format) + "_download"}})
+}
+
+// Follow writes the output of a job to w as plain text while the job runs, polling for new lines,
+// until the job finishes and all its output is written. opts may be nil.
+func (j *jobServiceHTTP) Follow(ctx context.Context, id int, w io.Writer, opts *FollowOptions) error {
+	interval := DefaultWaitInterval
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+
+	lines := &StdoutOptions{}
+	for {
+		// the job is checked first: once it finished, the output which follows is complete
+		job, err := j.GetJobContext(ctx, id, nil)
+		if err != nil {
+			return err
+		}
+		done := IsJobFinished(job.Status) && job.EventProcessingFinished
+
+		stdout, err := j.StdoutLines(ctx, id, lines)
+		if err != nil {
+			return err
+		}
+		if stdout.Range.End > lines.StartLine {
+			if _, err := io.WriteString(w, ansiEscape.ReplaceAllString(stdout.Content, "")); err != nil {
+				return err
+			}
+			lines.StartLine = stdout.Range.End
+		}
+
+		if done {
+			return nil
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newStdoutServer serves the output of the job 42, one more line on each poll of the job.
+func newStdoutServer(t *testing.T, lines []string) *testServer {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		written int
+	)
+	return newTestServer(t, testRoutes{
+		"/api/v2/jobs/42/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if written < len(lines) {
+				written++
+			}
+			status := JobStatusRunning
+			if written == len(lines) {
+				status = JobStatusSuccessful
+			}
+			fmt.Fprintf(w, `{"id": 42, "status": %q, "event_processing_finished": %t}`, status, written == len(lines))
+		},
+		"/api/v2/jobs/42/stdout/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			query := r.URL.Query()
+			start, _ := strconv.Atoi(query.Get("start_line"))
+			end := written
+			if query.Has("end_line") {
+				end, _ = strconv.Atoi(query.Get("end_line"))
+			}
+			content := strings.Join(lines[start:end], "")
+
+			switch query.Get("format") {
+			case "json":
+				writeJSON(w, map[string]interface{}{
+					"range":   map[string]int{"start": start, "end": end, "absolute_end": written},
+					"content": content,
+				})
+			case "txt_download":
+				w.Header().Set("Content-Disposition", `attachment; filename="job_42.txt"`)
+				fmt.Fprint(w, strings.Join(lines, ""))
+			default:
+				fmt.Fprint(w, content)
+			}
+		},
+	})
+}
+
+func TestJobStdout(t *testing.T) {
+	lines := []string{"PLAY [all]\n", "TASK [ping]\n", "\x1b[0;32mok: [web-01]\x1b[0m\n"}
+	server := newStdoutServer(t, lines)
+	jobs := &jobServiceHTTP{client: server.awxClient()}
+	ctx := context.Background()
+
+	// let the job write its three lines
+	for i := 0; i < len(lines); i++ {
+		jobs.GetJob(42, nil)
+	}
+
+	stdout, err := jobs.Stdout(ctx, 42, StdoutText, &StdoutOptions{StartLine: 1, EndLine: 2})
+	if err != nil {
+		t.Fatalf("Stdout err: %s", err)
+	}
+	if stdout != "TASK [ping]\n" {
+		t.Fatalf("Expecting the second line but got %q", stdout)
+	}
+
+	stdoutLines, err := jobs.StdoutLines(ctx, 42, &StdoutOptions{StartLine: 2})
+	if err != nil {
+		t.Fatalf("StdoutLines err: %s", err)
+	}
+	if stdoutLines.Range.Start != 2 || stdoutLines.Range.End != 3 || stdoutLines.Content != lines[2] {
+		t.Fatalf("Expecting the third line but got %+v", *stdoutLines)
+	}
+
+	reader, err := jobs.DownloadStdout(ctx, 42, StdoutText)
+	if err != nil {
+		t.Fatalf("DownloadStdout err: %s", err)
+	}
+	defer reader.Close()
+	downloaded, _ := io.ReadAll(reader)
+	if string(downloaded) != strings.Join(lines, "") {
+		t.Fatalf("Expecting the whole output but got %q", downloaded)
+	}
+
+	if _, err := jobs.DownloadStdout(ctx, 42, StdoutHTML); err == nil {
+		t.Fatalf("Expecting an error downloading the html output")
+	}
+}
+
+func TestJobFollow(t *testing.T) {
+	lines := []string{"PLAY [all]\n", "TASK [ping]\n", "\x1b[0;32mok: [web-01]\x1b[0m\n"}
+	server := newStdoutServer(t, lines)
+	jobs := &jobServiceHTTP{client: server.awxClient()}
+
+	var out strings.Builder
+	if err := jobs.Follow(context.Background(), 42, &out, &FollowOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatalf("Follow err: %s", err)
+	}
+
+	want := "PLAY [all]\nTASK [ping]\nok: [web-01]\n"
+	if out.String() != want {
+		t.Fatalf("Expecting %q but got %q", want, out.String())
+	}
+}
@@ -2,8 +2,8 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 )
 
@@ -14,10 +14,47 @@ type JobTemplateService interface {
 	Create(data map[string]interface{}, params map[string]string) (*JobTemplate, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error)
 	Delete(id int) (*JobTemplate, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*JobTemplate, *ResultsList[JobTemplate], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*JobTemplate, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*JobTemplate, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error)
+	DeleteContext(ctx context.Context, id int) (*JobTemplate, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*JobTemplate, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[JobTemplate]
+	ListQuery(ctx context.Context, q *Query) ([]*JobTemplate, *ResultsList[JobTemplate], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*JobTemplate, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[JobTemplate]
+	CreateWith(ctx context.Context, req CreateRequest[JobTemplate], params map[string]string) (*JobTemplate, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[JobTemplate], params map[string]string) (*JobTemplate, error)
+	GetByName(name string, scope map[string]string) (*JobTemplate, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*JobTemplate, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*JobTemplate, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*JobTemplate, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[JobTemplate]) (*EnsureResult[JobTemplate], error)
+	Copy(id int, newName string) (*JobTemplate, error)
+	CopyContext(ctx context.Context, id int, newName string) (*JobTemplate, error)
+	CanCopy(id int) (*CopyCapability, error)
+	CanCopyContext(ctx context.Context, id int) (*CopyCapability, error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*JobTemplate, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 
 	LaunchJob(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error)
+	LaunchJobContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error)
+	LaunchAndWait(ctx context.Context, id int, data map[string]interface{}, opts *WaitOptions) (*LaunchResult, error)
+	GetLaunchRequirements(id int) (*LaunchRequirements, error)
+	GetLaunchRequirementsContext(ctx context.Context, id int) (*LaunchRequirements, error)
+	ValidateLaunch(id int, launchData map[string]interface{}) (*LaunchValidation, error)
+	ValidateLaunchContext(ctx context.Context, id int, launchData map[string]interface{}) (*LaunchValidation, error)
 	DisAssociateCredentials(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error)
+	DisAssociateCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error)
 	AssociateCredentials(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error)
+	AssociateCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error)
+	CredentialsAssociation() *Association[JobTemplate, Credential]
 }
 
 type jobTemplateServiceHTTP struct {
@@ -33,8 +70,116 @@ type ListJobTemplatesResponse struct {
 
 const jobTemplatesAPIEndpoint = "/api/v2/job_templates/"
 
+// JobTemplateCreateRequest holds the fields of a new job template. The inventory may be left
+// empty when it is prompted on launch.
+type JobTemplateCreateRequest struct {
+	Name                  string `json:"name"`
+	Description           string `json:"description,omitempty"`
+	Organization          int    `json:"organization,omitempty"`
+	JobType               string `json:"job_type,omitempty"`
+	Inventory             int    `json:"inventory,omitempty"`
+	Project               int    `json:"project"`
+	Playbook              string `json:"playbook"`
+	ScmBranch             string `json:"scm_branch,omitempty"`
+	Forks                 int    `json:"forks,omitempty"`
+	Limit                 string `json:"limit,omitempty"`
+	Verbosity             int    `json:"verbosity,omitempty"`
+	ExtraVars             string `json:"extra_vars,omitempty"`
+	JobTags               string `json:"job_tags,omitempty"`
+	ForceHandlers         *bool  `json:"force_handlers,omitempty"`
+	SkipTags              string `json:"skip_tags,omitempty"`
+	StartAtTask           string `json:"start_at_task,omitempty"`
+	Timeout               int    `json:"timeout,omitempty"`
+	UseFactCache          *bool  `json:"use_fact_cache,omitempty"`
+	HostConfigKey         string `json:"host_config_key,omitempty"`
+	AskScmBranchOnLaunch  *bool  `json:"ask_scm_branch_on_launch,omitempty"`
+	AskDiffModeOnLaunch   *bool  `json:"ask_diff_mode_on_launch,omitempty"`
+	AskVariablesOnLaunch  *bool  `json:"ask_variables_on_launch,omitempty"`
+	AskLimitOnLaunch      *bool  `json:"ask_limit_on_launch,omitempty"`
+	AskTagsOnLaunch       *bool  `json:"ask_tags_on_launch,omitempty"`
+	AskSkipTagsOnLaunch   *bool  `json:"ask_skip_tags_on_launch,omitempty"`
+	AskJobTypeOnLaunch    *bool  `json:"ask_job_type_on_launch,omitempty"`
+	AskVerbosityOnLaunch  *bool  `json:"ask_verbosity_on_launch,omitempty"`
+	AskInventoryOnLaunch  *bool  `json:"ask_inventory_on_launch,omitempty"`
+	AskCredentialOnLaunch *bool  `json:"ask_credential_on_launch,omitempty"`
+	SurveyEnabled         *bool  `json:"survey_enabled,omitempty"`
+	BecomeEnabled         *bool  `json:"become_enabled,omitempty"`
+	DiffMode              *bool  `json:"diff_mode,omitempty"`
+	AllowSimultaneous     *bool  `json:"allow_simultaneous,omitempty"`
+	ExecutionEnvironment  int    `json:"execution_environment,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *JobTemplateCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("project", r.Project != 0),
+		required("playbook", r.Playbook != ""),
+		required("inventory", r.Inventory != 0 || deref(r.AskInventoryOnLaunch)),
+		oneOf("job_type", r.JobType, "run", "check"),
+		between("verbosity", &r.Verbosity, 0, 5),
+	)
+}
+
+func (*JobTemplateCreateRequest) createRequest(*JobTemplate) {}
+
+// JobTemplateUpdateRequest changes some fields of a job template, e.g. its playbook or its prompts on launch.
+type JobTemplateUpdateRequest struct {
+	Name                  *string `json:"name,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	Organization          *int    `json:"organization,omitempty"`
+	JobType               *string `json:"job_type,omitempty"`
+	Inventory             *int    `json:"inventory,omitempty"`
+	Project               *int    `json:"project,omitempty"`
+	Playbook              *string `json:"playbook,omitempty"`
+	ScmBranch             *string `json:"scm_branch,omitempty"`
+	Forks                 *int    `json:"forks,omitempty"`
+	Limit                 *string `json:"limit,omitempty"`
+	Verbosity             *int    `json:"verbosity,omitempty"`
+	ExtraVars             *string `json:"extra_vars,omitempty"`
+	JobTags               *string `json:"job_tags,omitempty"`
+	ForceHandlers         *bool   `json:"force_handlers,omitempty"`
+	SkipTags              *string `json:"skip_tags,omitempty"`
+	StartAtTask           *string `json:"start_at_task,omitempty"`
+	Timeout               *int    `json:"timeout,omitempty"`
+	UseFactCache          *bool   `json:"use_fact_cache,omitempty"`
+	HostConfigKey         *string `json:"host_config_key,omitempty"`
+	AskScmBranchOnLaunch  *bool   `json:"ask_scm_branch_on_launch,omitempty"`
+	AskDiffModeOnLaunch   *bool   `json:"ask_diff_mode_on_launch,omitempty"`
+	AskVariablesOnLaunch  *bool   `json:"ask_variables_on_launch,omitempty"`
+	AskLimitOnLaunch      *bool   `json:"ask_limit_on_launch,omitempty"`
+	AskTagsOnLaunch       *bool   `json:"ask_tags_on_launch,omitempty"`
+	AskSkipTagsOnLaunch   *bool   `json:"ask_skip_tags_on_launch,omitempty"`
+	AskJobTypeOnLaunch    *bool   `json:"ask_job_type_on_launch,omitempty"`
+	AskVerbosityOnLaunch  *bool   `json:"ask_verbosity_on_launch,omitempty"`
+	AskInventoryOnLaunch  *bool   `json:"ask_inventory_on_launch,omitempty"`
+	AskCredentialOnLaunch *bool   `json:"ask_credential_on_launch,omitempty"`
+	SurveyEnabled         *bool   `json:"survey_enabled,omitempty"`
+	BecomeEnabled         *bool   `json:"become_enabled,omitempty"`
+	DiffMode              *bool   `json:"diff_mode,omitempty"`
+	AllowSimultaneous     *bool   `json:"allow_simultaneous,omitempty"`
+	ExecutionEnvironment  *int    `json:"execution_environment,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *JobTemplateUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		notEmpty("playbook", r.Playbook),
+		oneOf("job_type", deref(r.JobType), "run", "check"),
+		between("verbosity", r.Verbosity, 0, 5),
+	)
+}
+
+func (*JobTemplateUpdateRequest) updateRequest(*JobTemplate) {}
+
 // Launch lauchs a job with the job template.
 func (jt *jobTemplateServiceHTTP) LaunchJob(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+	return jt.LaunchJobContext(context.Background(), id, data, params)
+}
+
+// LaunchJobContext is the context-aware version of LaunchJob.
+func (jt *jobTemplateServiceHTTP) LaunchJobContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/launch/", jobTemplatesAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -42,7 +187,7 @@ func (jt *jobTemplateServiceHTTP) LaunchJob(id int, data map[string]interface{},
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +198,7 @@ func (jt *jobTemplateServiceHTTP) LaunchJob(id int, data map[string]interface{},
 
 	// in case invalid job id return
 	if result.Job == 0 {
-		return nil, errors.New("invalid job id 0")
+		return nil, fmt.Errorf("%w: awx: launch returned no job id", ErrValidation)
 	}
 
 	return result, nil
@@ -61,25 +206,14 @@ func (jt *jobTemplateServiceHTTP) LaunchJob(id int, data map[string]interface{},
 
 // DisAssociateCredentials remove Credentials form an awx job template
 func (jt *jobTemplateServiceHTTP) DisAssociateCredentials(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+	return jt.DisAssociateCredentialsContext(context.Background(), id, data, params)
+}
+
+// DisAssociateCredentialsContext is the context-aware version of DisAssociateCredentials.
+func (jt *jobTemplateServiceHTTP) DisAssociateCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
-	endpoint := fmt.Sprintf("%s%d/credentials/", jobTemplatesAPIEndpoint, id)
 	data["disassociate"] = true
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	if err := jt.CredentialsAssociation().send(ctx, id, data, result); err != nil {
 		return nil, err
 	}
 
@@ -88,28 +222,21 @@ func (jt *jobTemplateServiceHTTP) DisAssociateCredentials(id int, data map[strin
 
 // AssociateCredentials  adding credentials to JobTemplate.
 func (jt *jobTemplateServiceHTTP) AssociateCredentials(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
-	result := new(JobTemplate)
+	return jt.AssociateCredentialsContext(context.Background(), id, data, params)
+}
 
-	endpoint := fmt.Sprintf("%s%d/credentials/", jobTemplatesAPIEndpoint, id)
+// AssociateCredentialsContext is the context-aware version of AssociateCredentials.
+func (jt *jobTemplateServiceHTTP) AssociateCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+	result := new(JobTemplate)
 	data["associate"] = true
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	if err := jt.CredentialsAssociation().send(ctx, id, data, result); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
+
+// CredentialsAssociation returns the Association of the credentials of the job templates.
+func (jt *jobTemplateServiceHTTP) CredentialsAssociation() *Association[JobTemplate, Credential] {
+	return NewAssociation[JobTemplate, Credential](jt.client, "credentials")
+}
@@ -1,52 +1,37 @@
 package awx
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-)
+import "context"
 
-const jobTemplateNotificationTemplatesAPIEndpoint = "/api/v2/job_templates/%d/notification_templates_%s/"
+const notificationTemplatesRelationPrefix = "notification_templates_"
 
 // JobTemplateNotificationTemplatesService implements awx job template nodes apis.
 type JobTemplateNotificationTemplateService interface {
 	AssociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	AssociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	AssociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	DisassociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	DisassociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	DisassociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 }
 
 type jobTemplateNotificationTemplateServiceHTTP struct {
 	client *Client
 }
 
-func (jt *jobTemplateNotificationTemplateServiceHTTP) associateJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (jt *jobTemplateNotificationTemplateServiceHTTP) associateJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
 		"id": notificationTemplateID,
 	}
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-
-	endpoint := fmt.Sprintf(jobTemplateNotificationTemplatesAPIEndpoint, jobTemplateID, typ)
-
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	relation := notificationTemplatesRelationPrefix + typ
+	if err := NewAssociation[JobTemplate, NotificationTemplate](jt.client, relation).send(ctx, jobTemplateID, data, result); err != nil {
 		return nil, err
 	}
 
@@ -55,45 +40,43 @@ func (jt *jobTemplateNotificationTemplateServiceHTTP) associateJobTemplateNotifi
 
 // AssociateJobTemplateNotificationTemplatesError will associate an error notification_template for a job_template
 func (jt *jobTemplateNotificationTemplateServiceHTTP) AssociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.associateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+	return jt.AssociateJobTemplateNotificationTemplatesErrorContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// AssociateJobTemplateNotificationTemplatesErrorContext is the context-aware version of AssociateJobTemplateNotificationTemplatesError.
+func (jt *jobTemplateNotificationTemplateServiceHTTP) AssociateJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.associateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // AssociateJobTemplateNotificationTemplatesSuccess will associate a success notification_template for a job_template
 func (jt *jobTemplateNotificationTemplateServiceHTTP) AssociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.associateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+	return jt.AssociateJobTemplateNotificationTemplatesSuccessContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// AssociateJobTemplateNotificationTemplatesSuccessContext is the context-aware version of AssociateJobTemplateNotificationTemplatesSuccess.
+func (jt *jobTemplateNotificationTemplateServiceHTTP) AssociateJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.associateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // AssociateJobTemplateNotificationTemplatesStarted will associate a started notification_template for a job_template
 func (jt *jobTemplateNotificationTemplateServiceHTTP) AssociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.associateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+	return jt.AssociateJobTemplateNotificationTemplatesStartedContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// AssociateJobTemplateNotificationTemplatesStartedContext is the context-aware version of AssociateJobTemplateNotificationTemplatesStarted.
+func (jt *jobTemplateNotificationTemplateServiceHTTP) AssociateJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.associateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
 
-func (jt *jobTemplateNotificationTemplateServiceHTTP) disassociateJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (jt *jobTemplateNotificationTemplateServiceHTTP) disassociateJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
 		"id":           notificationTemplateID,
 		"disassociate": true,
 	}
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-
-	endpoint := fmt.Sprintf(jobTemplateNotificationTemplatesAPIEndpoint, jobTemplateID, typ)
-
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	relation := notificationTemplatesRelationPrefix + typ
+	if err := NewAssociation[JobTemplate, NotificationTemplate](jt.client, relation).send(ctx, jobTemplateID, data, result); err != nil {
 		return nil, err
 	}
 
@@ -102,15 +85,30 @@ func (jt *jobTemplateNotificationTemplateServiceHTTP) disassociateJobTemplateNot
 
 // DisassociateJobTemplateNotificationTemplatesError will disassociate an error notification_template for a job_template
 func (jt *jobTemplateNotificationTemplateServiceHTTP) DisassociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.disassociateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+	return jt.DisassociateJobTemplateNotificationTemplatesErrorContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// DisassociateJobTemplateNotificationTemplatesErrorContext is the context-aware version of DisassociateJobTemplateNotificationTemplatesError.
+func (jt *jobTemplateNotificationTemplateServiceHTTP) DisassociateJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.disassociateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // DisassociateJobTemplateNotificationTemplatesSuccess will disassociate a success notification_template for a job_template
 func (jt *jobTemplateNotificationTemplateServiceHTTP) DisassociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.disassociateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+	return jt.DisassociateJobTemplateNotificationTemplatesSuccessContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// DisassociateJobTemplateNotificationTemplatesSuccessContext is the context-aware version of DisassociateJobTemplateNotificationTemplatesSuccess.
+func (jt *jobTemplateNotificationTemplateServiceHTTP) DisassociateJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.disassociateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // DisassociateJobTemplateNotificationTemplatesStarted will disassociate a started notification_template for a job_template
 func (jt *jobTemplateNotificationTemplateServiceHTTP) DisassociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.disassociateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+	return jt.DisassociateJobTemplateNotificationTemplatesStartedContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// DisassociateJobTemplateNotificationTemplatesStartedContext is the context-aware version of DisassociateJobTemplateNotificationTemplatesStarted.
+func (jt *jobTemplateNotificationTemplateServiceHTTP) DisassociateJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.disassociateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
@@ -0,0 +1,127 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Defaults used by WaitOptions when the matching field is left empty.
+const (
+	DefaultWaitInterval    = 2 * time.Second
+	DefaultWaitMaxInterval = 30 * time.Second
+)
+
+// WaitOptions configures how JobService.Wait polls a job, a nil *WaitOptions uses the defaults.
+type WaitOptions struct {
+	// Interval is the delay between two polls, DefaultWaitInterval when zero.
+	Interval time.Duration
+	// MaxInterval caps the delay between two polls, DefaultWaitMaxInterval when zero.
+	MaxInterval time.Duration
+	// Backoff multiplies the delay after each poll seeing no status change, the delay is constant below 1.
+	// The delay goes back to Interval when the status changes.
+	Backoff float64
+	// OnTransition is called each time the status of the job changes, from is empty on the first poll.
+	OnTransition func(id int, from, to string)
+}
+
+// JobFailedError is returned by Wait when a job or a workflow job finished without succeeding.
+type JobFailedError struct {
+	ID int
+	// Type is the type of the job, JobTypeJob or JobTypeWorkflowJob.
+	Type string
+	// Status is one of JobStatusFailed, JobStatusError and JobStatusCanceled.
+	Status          string
+	JobExplanation  string
+	ResultTraceback string
+}
+
+// Error implements the error interface.
+func (e *JobFailedError) Error() string {
+	message := fmt.Sprintf("awx: %s %d %s", strings.ReplaceAll(e.Type, "_", " "), e.ID, e.Status)
+	if e.JobExplanation != "" {
+		message += ": " + e.JobExplanation
+	}
+	return message
+}
+
+// IsJobFinished reports whether status is a terminal job status: successful, failed, error or canceled.
+func IsJobFinished(status string) bool {
+	switch status {
+	case JobStatusSuccessful, JobStatusFailed, JobStatusError, JobStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// Wait polls the job until it finishes and returns it. When the job did not succeed, the job is returned
+// along with a *JobFailedError. When ctx is done first, the last polled job is returned with the context error.
+func (j *jobServiceHTTP) Wait(ctx context.Context, id int, opts *WaitOptions) (*Job, error) {
+	job, err := waitUntilFinished(ctx, id, opts, func(ctx context.Context) (*Job, string, error) {
+		job, err := j.GetJobContext(ctx, id, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		return job, job.Status, nil
+	})
+	if err != nil || job.Status == JobStatusSuccessful {
+		return job, err
+	}
+
+	return job, &JobFailedError{
+		ID:              id,
+		Type:            JobTypeJob,
+		Status:          job.Status,
+		JobExplanation:  job.JobExplanation,
+		ResultTraceback: job.ResultTraceback,
+	}
+}
+
+// waitUntilFinished calls poll until the status it returns is terminal, following opts.
+func waitUntilFinished[T any](ctx context.Context, id int, opts *WaitOptions, poll func(context.Context) (*T, string, error)) (*T, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+	interval, maxInterval := opts.Interval, opts.MaxInterval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+
+	var (
+		last   *T
+		status string
+		delay  = interval
+	)
+	for {
+		current, currentStatus, err := poll(ctx)
+		if err != nil {
+			return last, err
+		}
+		last = current
+
+		if currentStatus != status {
+			if opts.OnTransition != nil {
+				opts.OnTransition(id, status, currentStatus)
+			}
+			status = currentStatus
+			delay = interval
+		} else if opts.Backoff > 1 {
+			delay = time.Duration(float64(delay) * opts.Backoff)
+		}
+		if delay > maxInterval {
+			delay = maxInterval
+		}
+
+		if IsJobFinished(status) {
+			return last, nil
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return last, err
+		}
+	}
+}
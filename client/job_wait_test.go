@@ -0,0 +1,124 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newJobServer serves the job 42, going through statuses one poll after the other.
+func newJobServer(t *testing.T, statuses ...string) *testServer {
+	t.Helper()
+
+	var polls atomic.Int64
+	return newTestServer(t, testRoutes{"GET /api/v2/jobs/42/": func(w http.ResponseWriter, r *http.Request) {
+		i := int(polls.Add(1)) - 1
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		fmt.Fprintf(w, `{"id": 42, "status": %q, "job_explanation": "Task failed", "result_traceback": "Traceback"}`, statuses[i])
+	}})
+}
+
+func TestJobWait(t *testing.T) {
+	testTable := []struct {
+		name        string
+		statuses    []string
+		transitions []string
+		wantStatus  string
+	}{
+		{
+			name:        "successful",
+			statuses:    []string{JobStatusPending, JobStatusRunning, JobStatusRunning, JobStatusSuccessful},
+			transitions: []string{"->pending", "pending->running", "running->successful"},
+			wantStatus:  JobStatusSuccessful,
+		},
+		{
+			name:        "failed",
+			statuses:    []string{JobStatusRunning, JobStatusFailed},
+			transitions: []string{"->running", "running->failed"},
+			wantStatus:  JobStatusFailed,
+		},
+		{
+			name:        "canceled",
+			statuses:    []string{JobStatusCanceled},
+			transitions: []string{"->canceled"},
+			wantStatus:  JobStatusCanceled,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			server := newJobServer(t, test.statuses...)
+			jobs := &jobServiceHTTP{client: server.awxClient()}
+
+			var transitions []string
+			job, err := jobs.Wait(context.Background(), 42, &WaitOptions{
+				Interval: time.Millisecond,
+				Backoff:  2,
+				OnTransition: func(id int, from, to string) {
+					transitions = append(transitions, from+"->"+to)
+				},
+			})
+
+			if job == nil || job.Status != test.wantStatus {
+				t.Fatalf("Expecting a %s job but got %v", test.wantStatus, job)
+			}
+			if !reflect.DeepEqual(transitions, test.transitions) {
+				t.Fatalf("Expecting transitions %v but got %v", test.transitions, transitions)
+			}
+
+			if test.wantStatus == JobStatusSuccessful {
+				if err != nil {
+					t.Fatalf("Wait err: %s", err)
+				}
+				return
+			}
+
+			var failedErr *JobFailedError
+			if !errors.As(err, &failedErr) {
+				t.Fatalf("Expecting a *JobFailedError but got %v", err)
+			}
+			if failedErr.Type != JobTypeJob || failedErr.Status != test.wantStatus || failedErr.JobExplanation != "Task failed" || failedErr.ResultTraceback != "Traceback" {
+				t.Fatalf("Expecting the %s job details but got %+v", test.wantStatus, *failedErr)
+			}
+		})
+	}
+}
+
+func TestJobFailedError(t *testing.T) {
+	testTable := []struct {
+		err  *JobFailedError
+		want string
+	}{
+		{err: &JobFailedError{ID: 42, Type: JobTypeJob, Status: JobStatusFailed, JobExplanation: "Task failed"}, want: "awx: job 42 failed: Task failed"},
+		{err: &JobFailedError{ID: 9, Type: JobTypeWorkflowJob, Status: JobStatusCanceled}, want: "awx: workflow job 9 canceled"},
+	}
+
+	for _, test := range testTable {
+		if got := test.err.Error(); got != test.want {
+			t.Fatalf("Expecting %q but got %q", test.want, got)
+		}
+	}
+}
+
+func TestJobWaitContextDone(t *testing.T) {
+	server := newJobServer(t, JobStatusRunning)
+	jobs := &jobServiceHTTP{client: server.awxClient()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	job, err := jobs.Wait(ctx, 42, &WaitOptions{Interval: 10 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expecting the deadline to be exceeded but got %v", err)
+	}
+	if job == nil || job.Status != JobStatusRunning {
+		t.Fatalf("Expecting the last polled job but got %v", job)
+	}
+}
@@ -0,0 +1,215 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LaunchResult is the outcome of LaunchAndWait, once the job or the workflow job finished.
+type LaunchResult struct {
+	// ID is the id of the job, or of the workflow job.
+	ID      int
+	Status  string
+	Elapsed time.Duration
+	// Job is the finished job, nil for a workflow.
+	Job *Job
+	// WorkflowJob is the finished workflow job, nil for a job template.
+	WorkflowJob *WorkflowJob
+	// HostSummaries are the statistics of each host, for every playbook job of a workflow.
+	HostSummaries []*HostSummary
+	// FailedTasks are the tasks which failed or found a host unreachable, for every playbook job of a workflow.
+	// The failures ignored by `ignore_errors` are left out.
+	FailedTasks []*FailedTask
+	// Artifacts are the stats set by `set_stats`. For a workflow, the artifacts of its jobs are merged
+	// in the order of the nodes.
+	Artifacts map[string]interface{}
+}
+
+// FailedTask is a task which failed on a host.
+type FailedTask struct {
+	Job  int
+	Host string
+	Play string
+	Task string
+	// Event is either JobEventRunnerOnFailed or JobEventRunnerOnUnreachable.
+	Event string
+	// Message is the message of the module, or its standard error.
+	Message string
+}
+
+// LaunchAndWait launches a job from the job template, waits for it to finish and collects its host
+// summaries, failed tasks and artifacts. When the job did not succeed, the result is returned along
+// with a *JobFailedError.
+func (jt *jobTemplateServiceHTTP) LaunchAndWait(ctx context.Context, id int, data map[string]interface{}, opts *WaitOptions) (*LaunchResult, error) {
+	launch, err := jt.LaunchJobContext(ctx, id, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := &jobServiceHTTP{client: jt.client}
+	job, waitErr := jobs.Wait(ctx, launch.Job, opts)
+	if job == nil {
+		return nil, waitErr
+	}
+
+	result := &LaunchResult{
+		ID:      job.ID,
+		Status:  job.Status,
+		Elapsed: elapsedDuration(job.Elapsed),
+		Job:     job,
+	}
+	if !IsJobFinished(job.Status) {
+		return result, waitErr
+	}
+
+	job, err = jobs.waitEventProcessing(ctx, job, opts)
+	if err != nil {
+		return result, err
+	}
+	result.Job = job
+	if err := jobs.collect(ctx, job, result); err != nil {
+		return result, err
+	}
+	return result, waitErr
+}
+
+// LaunchAndWait launches a workflow job from the workflow job template, waits for it to finish and
+// collects the host summaries, failed tasks and artifacts of its playbook jobs. When the workflow job
+// did not succeed, the result is returned along with a *JobFailedError.
+func (jt *workflowJobTemplateServiceHTTP) LaunchAndWait(ctx context.Context, id int, data map[string]interface{}, opts *WaitOptions) (*LaunchResult, error) {
+	launch, err := jt.LaunchWorkflowContext(ctx, id, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowJobs := &workflowJobServiceHTTP{client: jt.client}
+	workflowJob, waitErr := workflowJobs.Wait(ctx, launch.ID, opts)
+	if workflowJob == nil {
+		return nil, waitErr
+	}
+
+	result := &LaunchResult{
+		ID:          workflowJob.ID,
+		Status:      workflowJob.Status,
+		Elapsed:     elapsedDuration(workflowJob.Elapsed),
+		WorkflowJob: workflowJob,
+	}
+	if !IsJobFinished(workflowJob.Status) {
+		return result, waitErr
+	}
+
+	nodes, err := workflowJobs.ListWorkflowJobNodesContext(ctx, workflowJob.ID, nil)
+	if err != nil {
+		return result, err
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+
+	jobs := &jobServiceHTTP{client: jt.client}
+	for _, node := range nodes {
+		// only the playbook jobs have hosts and events, not the project updates or the nested workflows
+		if !node.Job.Valid || node.SummaryFields == nil || node.SummaryFields.Job == nil || node.SummaryFields.Job.Type != "job" {
+			continue
+		}
+		job, err := jobs.GetJobContext(ctx, node.Job.ID, nil)
+		if err != nil {
+			return result, err
+		}
+		job, err = jobs.waitEventProcessing(ctx, job, opts)
+		if err != nil {
+			return result, err
+		}
+		if err := jobs.collect(ctx, job, result); err != nil {
+			return result, err
+		}
+	}
+	return result, waitErr
+}
+
+// waitEventProcessing polls the finished job until awx stored all its events, its host summaries and
+// events are incomplete before. The polls follow the Interval of opts.
+func (j *jobServiceHTTP) waitEventProcessing(ctx context.Context, job *Job, opts *WaitOptions) (*Job, error) {
+	interval := DefaultWaitInterval
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+
+	for !job.EventProcessingFinished {
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, err
+		}
+
+		var err error
+		job, err = j.GetJobContext(ctx, job.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return job, nil
+}
+
+// collect adds the host summaries, failed tasks and artifacts of a finished job, whose events
+// are processed, to result.
+func (j *jobServiceHTTP) collect(ctx context.Context, job *Job, result *LaunchResult) error {
+	summaries, err := ListAll[HostSummary](ctx, j.client, fmt.Sprintf("%s%d/job_host_summaries/", jobAPIEndpoint, job.ID), nil)
+	if err != nil {
+		return err
+	}
+	result.HostSummaries = append(result.HostSummaries, summaries...)
+
+	events := j.Events(ctx, job.ID, &JobEventOptions{Events: []string{JobEventRunnerOnFailed, JobEventRunnerOnUnreachable}})
+	for events.Next() {
+		event := events.Value()
+		if event.EventData != nil && event.EventData.IgnoreErrors {
+			continue
+		}
+		result.FailedTasks = append(result.FailedTasks, &FailedTask{
+			Job:     job.ID,
+			Host:    event.HostName,
+			Play:    event.Play,
+			Task:    event.Task,
+			Event:   event.Event,
+			Message: eventMessage(event),
+		})
+	}
+	if err := events.Err(); err != nil {
+		return err
+	}
+
+	for key, value := range job.Artifacts {
+		if result.Artifacts == nil {
+			result.Artifacts = map[string]interface{}{}
+		}
+		result.Artifacts[key] = value
+	}
+	return nil
+}
+
+// eventMessage returns the message of the module which failed, its standard error when it has none.
+func eventMessage(event *JobEvent) string {
+	if event.EventData == nil || event.EventData.Res == nil {
+		return ""
+	}
+
+	res := event.EventData.Res
+	switch msg := res.Msg.(type) {
+	case string:
+		if msg != "" {
+			return msg
+		}
+	case []interface{}:
+		lines := make([]string, len(msg))
+		for i, line := range msg {
+			lines[i] = fmt.Sprint(line)
+		}
+		return strings.Join(lines, "\n")
+	}
+	return res.Stderr
+}
+
+// elapsedDuration converts the elapsed seconds reported by awx.
+func elapsedDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,247 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LaunchRequirements represents the awx api `launch` endpoint of a job or workflow job template:
+// what is prompted on launch and what must be given to start a job.
+type LaunchRequirements struct {
+	CanStartWithoutUserInput bool `json:"can_start_without_user_input"`
+	// PasswordsNeededToStart lists the passwords of the credentials to give on launch, e.g. `ssh_password`.
+	PasswordsNeededToStart []string `json:"passwords_needed_to_start"`
+	// VariablesNeededToStart lists the required survey variables.
+	VariablesNeededToStart  []string `json:"variables_needed_to_start"`
+	CredentialNeededToStart bool     `json:"credential_needed_to_start"`
+	InventoryNeededToStart  bool     `json:"inventory_needed_to_start"`
+	SurveyEnabled           bool     `json:"survey_enabled"`
+
+	AskScmBranchOnLaunch            bool `json:"ask_scm_branch_on_launch"`
+	AskVariablesOnLaunch            bool `json:"ask_variables_on_launch"`
+	AskTagsOnLaunch                 bool `json:"ask_tags_on_launch"`
+	AskDiffModeOnLaunch             bool `json:"ask_diff_mode_on_launch"`
+	AskSkipTagsOnLaunch             bool `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch              bool `json:"ask_job_type_on_launch"`
+	AskLimitOnLaunch                bool `json:"ask_limit_on_launch"`
+	AskVerbosityOnLaunch            bool `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch            bool `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch           bool `json:"ask_credential_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool `json:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch               bool `json:"ask_labels_on_launch"`
+	AskForksOnLaunch                bool `json:"ask_forks_on_launch"`
+	AskJobSliceCountOnLaunch        bool `json:"ask_job_slice_count_on_launch"`
+	AskTimeoutOnLaunch              bool `json:"ask_timeout_on_launch"`
+	AskInstanceGroupsOnLaunch       bool `json:"ask_instance_groups_on_launch"`
+
+	Defaults *LaunchDefaults `json:"defaults"`
+
+	// NodeTemplatesMissing lists the workflow nodes without a job template, workflows only.
+	NodeTemplatesMissing []int `json:"node_templates_missing"`
+	// NodePromptsRejected lists the workflow nodes whose prompts are rejected, workflows only.
+	NodePromptsRejected []int `json:"node_prompts_rejected"`
+}
+
+// LaunchDefaults represents the values used on launch when none is given.
+type LaunchDefaults struct {
+	ExtraVars   string                     `json:"extra_vars"`
+	DiffMode    bool                       `json:"diff_mode"`
+	Limit       string                     `json:"limit"`
+	JobTags     string                     `json:"job_tags"`
+	SkipTags    string                     `json:"skip_tags"`
+	JobType     string                     `json:"job_type"`
+	Verbosity   int                        `json:"verbosity"`
+	ScmBranch   string                     `json:"scm_branch"`
+	Inventory   *LaunchDefaultInventory    `json:"inventory"`
+	Credentials []*LaunchDefaultCredential `json:"credentials"`
+}
+
+// LaunchDefaultInventory represents the default inventory of a launch.
+type LaunchDefaultInventory struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// LaunchDefaultCredential represents a default credential of a launch.
+type LaunchDefaultCredential struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	CredentialType int    `json:"credential_type"`
+	// PasswordsNeeded lists the passwords of the credential to give on launch.
+	PasswordsNeeded []string `json:"passwords_needed"`
+}
+
+// LaunchValidation reports the problems of launch data found by ValidateLaunch.
+type LaunchValidation struct {
+	// Missing lists the fields required to start which are not given: `inventory`, `credentials`,
+	// a password such as `ssh_password`, or `extra_vars.<variable>` for a required survey variable.
+	Missing []string
+	// Ignored lists the given fields awx ignores, since the template does not prompt for them.
+	Ignored []string
+}
+
+// Err returns an error matching ErrValidation when fields are missing, nil otherwise.
+// The ignored fields do not prevent a launch.
+func (v *LaunchValidation) Err() error {
+	if len(v.Missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: missing launch fields: %s", ErrValidation, strings.Join(v.Missing, ", "))
+}
+
+// prompts maps the launch fields to whether the template prompts for them.
+func (r *LaunchRequirements) prompts() map[string]bool {
+	return map[string]bool{
+		"extra_vars":            r.AskVariablesOnLaunch || r.SurveyEnabled,
+		"inventory":             r.AskInventoryOnLaunch,
+		"credentials":           r.AskCredentialOnLaunch,
+		"limit":                 r.AskLimitOnLaunch,
+		"job_tags":              r.AskTagsOnLaunch,
+		"skip_tags":             r.AskSkipTagsOnLaunch,
+		"job_type":              r.AskJobTypeOnLaunch,
+		"verbosity":             r.AskVerbosityOnLaunch,
+		"diff_mode":             r.AskDiffModeOnLaunch,
+		"scm_branch":            r.AskScmBranchOnLaunch,
+		"execution_environment": r.AskExecutionEnvironmentOnLaunch,
+		"labels":                r.AskLabelsOnLaunch,
+		"forks":                 r.AskForksOnLaunch,
+		"job_slice_count":       r.AskJobSliceCountOnLaunch,
+		"timeout":               r.AskTimeoutOnLaunch,
+		"instance_groups":       r.AskInstanceGroupsOnLaunch,
+	}
+}
+
+// Validate checks launchData against the requirements, without contacting awx.
+// The survey variables are only checked when `extra_vars` is a map or a JSON object.
+func (r *LaunchRequirements) Validate(launchData map[string]interface{}) *LaunchValidation {
+	validation := &LaunchValidation{Missing: []string{}, Ignored: []string{}}
+
+	if r.InventoryNeededToStart && launchData["inventory"] == nil {
+		validation.Missing = append(validation.Missing, "inventory")
+	}
+	if r.CredentialNeededToStart && launchData["credentials"] == nil {
+		validation.Missing = append(validation.Missing, "credentials")
+	}
+
+	passwords := map[string]bool{}
+	for _, password := range r.PasswordsNeededToStart {
+		passwords[password] = true
+		if value, _ := launchData[password].(string); value == "" {
+			validation.Missing = append(validation.Missing, password)
+		}
+	}
+
+	if variables, ok := extraVars(launchData["extra_vars"]); ok {
+		for _, variable := range r.VariablesNeededToStart {
+			if _, found := variables[variable]; !found {
+				validation.Missing = append(validation.Missing, "extra_vars."+variable)
+			}
+		}
+	}
+
+	prompts := r.prompts()
+	for field := range launchData {
+		if prompted, known := prompts[field]; prompted || (!known && passwords[field]) {
+			continue
+		}
+		validation.Ignored = append(validation.Ignored, field)
+	}
+	sort.Strings(validation.Ignored)
+
+	return validation
+}
+
+// extraVars decodes the extra variables of launch data, given as a map or a JSON object.
+func extraVars(value interface{}) (map[string]interface{}, bool) {
+	switch v := value.(type) {
+	case nil:
+		return map[string]interface{}{}, true
+	case map[string]interface{}:
+		return v, true
+	case map[string]string:
+		variables := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			variables[key] = value
+		}
+		return variables, true
+	case string:
+		variables := map[string]interface{}{}
+		if v == "" {
+			return variables, true
+		}
+		if err := json.Unmarshal([]byte(v), &variables); err != nil {
+			// YAML extra variables cannot be checked
+			return nil, false
+		}
+		return variables, true
+	}
+	return nil, false
+}
+
+// getLaunchRequirements reads the `launch` endpoint of the template id under endpoint.
+func getLaunchRequirements(ctx context.Context, client *Client, endpoint string, id int) (*LaunchRequirements, error) {
+	result := new(LaunchRequirements)
+	resp, err := client.Requester.GetJSONContext(ctx, fmt.Sprintf("%s%d/launch/", endpoint, id), result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetLaunchRequirements returns what the job template prompts for and requires to launch a job.
+func (jt *jobTemplateServiceHTTP) GetLaunchRequirements(id int) (*LaunchRequirements, error) {
+	return jt.GetLaunchRequirementsContext(context.Background(), id)
+}
+
+// GetLaunchRequirementsContext is the context-aware version of GetLaunchRequirements.
+func (jt *jobTemplateServiceHTTP) GetLaunchRequirementsContext(ctx context.Context, id int) (*LaunchRequirements, error) {
+	return getLaunchRequirements(ctx, jt.client, jobTemplatesAPIEndpoint, id)
+}
+
+// ValidateLaunch checks the data of a LaunchJob against the launch requirements of the job template.
+// The problems found are reported by the LaunchValidation, the error only tells the requirements could not be read.
+func (jt *jobTemplateServiceHTTP) ValidateLaunch(id int, launchData map[string]interface{}) (*LaunchValidation, error) {
+	return jt.ValidateLaunchContext(context.Background(), id, launchData)
+}
+
+// ValidateLaunchContext is the context-aware version of ValidateLaunch.
+func (jt *jobTemplateServiceHTTP) ValidateLaunchContext(ctx context.Context, id int, launchData map[string]interface{}) (*LaunchValidation, error) {
+	requirements, err := jt.GetLaunchRequirementsContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return requirements.Validate(launchData), nil
+}
+
+// GetLaunchRequirements returns what the workflow job template prompts for and requires to launch a workflow job.
+func (jt *workflowJobTemplateServiceHTTP) GetLaunchRequirements(id int) (*LaunchRequirements, error) {
+	return jt.GetLaunchRequirementsContext(context.Background(), id)
+}
+
+// GetLaunchRequirementsContext is the context-aware version of GetLaunchRequirements.
+func (jt *workflowJobTemplateServiceHTTP) GetLaunchRequirementsContext(ctx context.Context, id int) (*LaunchRequirements, error) {
+	return getLaunchRequirements(ctx, jt.client, workflowJobTemplateAPIEndpoint, id)
+}
+
+// ValidateLaunch checks the data of a LaunchWorkflow against the launch requirements of the workflow job template.
+// The problems found are reported by the LaunchValidation, the error only tells the requirements could not be read.
+func (jt *workflowJobTemplateServiceHTTP) ValidateLaunch(id int, launchData map[string]interface{}) (*LaunchValidation, error) {
+	return jt.ValidateLaunchContext(context.Background(), id, launchData)
+}
+
+// ValidateLaunchContext is the context-aware version of ValidateLaunch.
+func (jt *workflowJobTemplateServiceHTTP) ValidateLaunchContext(ctx context.Context, id int, launchData map[string]interface{}) (*LaunchValidation, error) {
+	requirements, err := jt.GetLaunchRequirementsContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return requirements.Validate(launchData), nil
+}
@@ -0,0 +1,103 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestValidateLaunch(t *testing.T) {
+	server := newTestServer(t, testRoutes{"GET /api/v2/job_templates/1/launch/": func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"can_start_without_user_input": false,
+			"passwords_needed_to_start": ["ssh_password"],
+			"variables_needed_to_start": ["version"],
+			"inventory_needed_to_start": true,
+			"credential_needed_to_start": false,
+			"survey_enabled": true,
+			"ask_inventory_on_launch": true,
+			"ask_limit_on_launch": true,
+			"defaults": {"limit": "web", "inventory": {"id": null, "name": null},
+				"credentials": [{"id": 3, "name": "ssh", "credential_type": 1, "passwords_needed": ["ssh_password"]}]}
+		}`)
+	}})
+
+	jobTemplates := &jobTemplateServiceHTTP{client: server.awxClient()}
+
+	requirements, err := jobTemplates.GetLaunchRequirementsContext(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLaunchRequirements err: %s", err)
+	}
+	if !requirements.AskInventoryOnLaunch || requirements.Defaults.Limit != "web" || requirements.Defaults.Credentials[0].PasswordsNeeded[0] != "ssh_password" {
+		t.Fatalf("Expecting the launch requirements to be decoded but got %+v", *requirements)
+	}
+
+	testTable := []struct {
+		name        string
+		launchData  map[string]interface{}
+		wantMissing []string
+		wantIgnored []string
+	}{
+		{
+			name:        "empty",
+			launchData:  map[string]interface{}{},
+			wantMissing: []string{"inventory", "ssh_password", "extra_vars.version"},
+			wantIgnored: []string{},
+		},
+		{
+			name: "complete",
+			launchData: map[string]interface{}{
+				"inventory":    2,
+				"limit":        "db",
+				"ssh_password": "secret", // pragma: allowlist secret
+				"extra_vars":   map[string]interface{}{"version": "1.2"},
+			},
+			wantMissing: []string{},
+			wantIgnored: []string{},
+		},
+		{
+			name: "ignored",
+			launchData: map[string]interface{}{
+				"inventory":    2,
+				"ssh_password": "secret", // pragma: allowlist secret
+				"extra_vars":   `{"version": "1.2"}`,
+				"job_tags":     "deploy",
+				"forks":        10,
+			},
+			wantMissing: []string{},
+			wantIgnored: []string{"forks", "job_tags"},
+		},
+		{
+			name: "yaml extra vars",
+			launchData: map[string]interface{}{
+				"inventory":    2,
+				"ssh_password": "secret", // pragma: allowlist secret
+				"extra_vars":   "version: 1.2",
+			},
+			wantMissing: []string{},
+			wantIgnored: []string{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			validation, err := jobTemplates.ValidateLaunch(1, test.launchData)
+			if err != nil {
+				t.Fatalf("ValidateLaunch err: %s", err)
+			}
+
+			if !reflect.DeepEqual(validation.Missing, test.wantMissing) {
+				t.Fatalf("Expecting missing %v but got %v", test.wantMissing, validation.Missing)
+			}
+			if !reflect.DeepEqual(validation.Ignored, test.wantIgnored) {
+				t.Fatalf("Expecting ignored %v but got %v", test.wantIgnored, validation.Ignored)
+			}
+			if (len(test.wantMissing) > 0) != errors.Is(validation.Err(), ErrValidation) {
+				t.Fatalf("Expecting a validation error only when fields are missing but got %v", validation.Err())
+			}
+		})
+	}
+}
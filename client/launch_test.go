@@ -0,0 +1,138 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newLaunchServer fakes the job template 1 launching the failed job 7, and the workflow job template 2
+// launching the workflow job 9, whose nodes ran the job 7 and a project update. The events of the job 7
+// are processed from its second poll on.
+func newLaunchServer(t *testing.T) *testServer {
+	t.Helper()
+
+	var (
+		polls     atomic.Int64
+		processed atomic.Bool
+	)
+	checkProcessed := func() {
+		if !processed.Load() {
+			t.Errorf("Expecting the details read once the events are processed")
+		}
+	}
+	return newTestServer(t, testRoutes{
+		"/api/v2/job_templates/1/launch/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"job": 7, "id": 7}`)
+		},
+		"/api/v2/workflow_job_templates/2/launch/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"workflow_job": 9, "id": 9}`)
+		},
+		"/api/v2/jobs/7/": func(w http.ResponseWriter, r *http.Request) {
+			if polls.Add(1) == 1 {
+				fmt.Fprint(w, `{"id": 7, "status": "failed", "elapsed": 12.5, "event_processing_finished": false}`)
+				return
+			}
+			processed.Store(true)
+			fmt.Fprint(w, `{"id": 7, "status": "failed", "elapsed": 12.5, "event_processing_finished": true,
+				"artifacts": {"version": "1.2", "hosts": ["web-01"]}}`)
+		},
+		"/api/v2/jobs/7/job_host_summaries/": func(w http.ResponseWriter, r *http.Request) {
+			checkProcessed()
+			fmt.Fprint(w, `{"count": 2, "results": [{"host_name": "web-01", "ok": 3}, {"host_name": "web-02", "failures": 1}]}`)
+		},
+		"/api/v2/jobs/7/job_events/": func(w http.ResponseWriter, r *http.Request) {
+			checkProcessed()
+			if r.URL.Query().Get("counter__gt") != "0" {
+				fmt.Fprint(w, `{"count": 0, "results": []}`)
+				return
+			}
+			fmt.Fprint(w, `{"count": 2, "results": [
+				{"counter": 4, "event": "runner_on_failed", "host_name": "web-02", "play": "deploy", "task": "restart",
+				 "event_data": {"res": {"msg": "Service not found"}}},
+				{"counter": 5, "event": "runner_on_failed", "host_name": "web-02", "play": "deploy", "task": "probe",
+				 "event_data": {"ignore_errors": true, "res": {"msg": "ignored"}}}
+			]}`)
+		},
+		"/api/v2/workflow_jobs/9/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"id": 9, "status": "successful", "elapsed": 30}`)
+		},
+		"/api/v2/workflow_jobs/9/workflow_nodes/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"count": 3, "results": [
+				{"id": 2, "job": 7, "summary_fields": {"job": {"id": 7, "type": "job", "status": "failed"}}},
+				{"id": 1, "job": 8, "summary_fields": {"job": {"id": 8, "type": "project_update", "status": "successful"}}},
+				{"id": 3, "job": null, "do_not_run": true}
+			]}`)
+		},
+	})
+}
+
+func checkLaunchDetails(t *testing.T, result *LaunchResult) {
+	t.Helper()
+
+	if len(result.HostSummaries) != 2 || result.HostSummaries[1].Failures != 1 {
+		t.Fatalf("Expecting the summaries of web-01 and web-02 but got %v", result.HostSummaries)
+	}
+
+	wantTasks := []*FailedTask{{Job: 7, Host: "web-02", Play: "deploy", Task: "restart", Event: JobEventRunnerOnFailed, Message: "Service not found"}}
+	if !reflect.DeepEqual(result.FailedTasks, wantTasks) {
+		t.Fatalf("Expecting the restart task to fail but got %v", result.FailedTasks)
+	}
+
+	wantArtifacts := map[string]interface{}{"version": "1.2", "hosts": []interface{}{"web-01"}}
+	if !reflect.DeepEqual(result.Artifacts, wantArtifacts) {
+		t.Fatalf("Expecting artifacts %v but got %v", wantArtifacts, result.Artifacts)
+	}
+}
+
+func TestJobTemplateLaunchAndWait(t *testing.T) {
+	server := newLaunchServer(t)
+	client := server.awxClient()
+	jobTemplates := &jobTemplateServiceHTTP{client: client}
+
+	result, err := jobTemplates.LaunchAndWait(context.Background(), 1, nil, &WaitOptions{Interval: time.Millisecond})
+
+	var failedErr *JobFailedError
+	if !errors.As(err, &failedErr) || failedErr.ID != 7 {
+		t.Fatalf("Expecting the job 7 to fail but got %v", err)
+	}
+	if result.ID != 7 || result.Status != JobStatusFailed || result.Elapsed != 12500*time.Millisecond || result.Job == nil || !result.Job.EventProcessingFinished {
+		t.Fatalf("Expecting the failed job 7 after 12.5s but got %+v", *result)
+	}
+	checkLaunchDetails(t, result)
+}
+
+func TestWorkflowJobTemplateLaunchAndWait(t *testing.T) {
+	server := newLaunchServer(t)
+	client := server.awxClient()
+	workflowJobTemplates := &workflowJobTemplateServiceHTTP{client: client}
+
+	result, err := workflowJobTemplates.LaunchAndWait(context.Background(), 2, nil, &WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("LaunchAndWait err: %s", err)
+	}
+	if result.ID != 9 || result.Status != JobStatusSuccessful || result.WorkflowJob == nil || result.Job != nil {
+		t.Fatalf("Expecting the successful workflow job 9 but got %+v", *result)
+	}
+	checkLaunchDetails(t, result)
+}
+
+func TestJobArtifactsDecoding(t *testing.T) {
+	payload := []byte(`{"id": 7, "artifacts": {"version": "1.2", "replicas": 3, "hosts": ["web-01"]}}`)
+	want := JobArtifacts{"version": "1.2", "replicas": float64(3), "hosts": []interface{}{"web-01"}}
+
+	var launch JobLaunch
+	if err := json.Unmarshal(payload, &launch); err != nil || !reflect.DeepEqual(launch.Artifacts, want) {
+		t.Fatalf("Expecting the launch artifacts %v but got %v (%v)", want, launch.Artifacts, err)
+	}
+	var job Job
+	if err := json.Unmarshal(payload, &job); err != nil || !reflect.DeepEqual(job.Artifacts, want) {
+		t.Fatalf("Expecting the job artifacts %v but got %v (%v)", want, job.Artifacts, err)
+	}
+}
@@ -0,0 +1,86 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// namedURLSeparator separates the identifiers of the objects of a named URL.
+const namedURLSeparator = "++"
+
+// NamedURL builds the named URL identifier of an object from its name and the names of the objects
+// identifying it, in the order given by the `named_url` of the awx `Related` links, e.g.
+// NamedURL("deploy", "Default") is `deploy++Default` for a job template of the Default organization.
+// A `+` in a name is written `[+]`, as awx expects.
+func NamedURL(names ...string) string {
+	escaped := make([]string, len(names))
+	for i, name := range names {
+		escaped[i] = url.PathEscape(strings.ReplaceAll(name, "+", "[+]"))
+	}
+	return strings.Join(escaped, namedURLSeparator)
+}
+
+// GetByName returns the only object named name. scope restricts the lookup, e.g. to an
+// organization with `{"organization": "1"}` or `{"organization__name": "Default"}`.
+// The error matches ErrNotFound when no object matches and ErrAmbiguous when several do.
+func (rs *AWXResourceService[T]) GetByName(name string, scope map[string]string) (*T, error) {
+	return rs.GetByNameContext(context.Background(), name, scope)
+}
+
+// GetByNameContext is the context-aware version of GetByName.
+func (rs *AWXResourceService[T]) GetByNameContext(ctx context.Context, name string, scope map[string]string) (*T, error) {
+	nameField := rs.nameField
+	if nameField == "" {
+		nameField = "name"
+	}
+
+	params := make(map[string]string, len(scope)+2)
+	for key, value := range scope {
+		params[key] = value
+	}
+	params[nameField] = name
+	// a second object is enough to know the name is ambiguous
+	params["page_size"] = "2"
+
+	results, list, err := rs.ListContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(results) == 0:
+		return nil, fmt.Errorf("%w: no object of %s with %s %q", ErrNotFound, rs.basePath, nameField, name)
+	case len(results) > 1 || list.Count > 1:
+		return nil, fmt.Errorf("%w: %d objects of %s with %s %q, narrow the scope", ErrAmbiguous, list.Count, rs.basePath, nameField, name)
+	}
+	return results[0], nil
+}
+
+// GetByNamedURL returns the object identified by a named URL, either the identifier built by
+// NamedURL or the full path given by the `named_url` of its `Related` links.
+// The error matches ErrNotFound when no object matches.
+func (rs *AWXResourceService[T]) GetByNamedURL(namedURL string, params map[string]string) (*T, error) {
+	return rs.GetByNamedURLContext(context.Background(), namedURL, params)
+}
+
+// GetByNamedURLContext is the context-aware version of GetByNamedURL.
+func (rs *AWXResourceService[T]) GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*T, error) {
+	endpoint := namedURL
+	if !strings.HasPrefix(namedURL, "/") {
+		endpoint = rs.basePath + strings.TrimSuffix(namedURL, "/") + "/"
+	}
+
+	result := new(T)
+	resp, err := rs.client.Requester.GetJSONContext(ctx, endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
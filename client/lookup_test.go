@@ -0,0 +1,87 @@
+package awx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetByName(t *testing.T) {
+	// the server knows two users named admin, in different organizations, and one named jdoe
+	server := newTestServer(t, testRoutes{"GET " + usersAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("username") == "jdoe":
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 1, "username": "jdoe"}]}`)
+		case query.Get("username") == "admin" && query.Get("organization") == "2":
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 3, "username": "admin"}]}`)
+		case query.Get("username") == "admin":
+			fmt.Fprint(w, `{"count": 2, "results": [{"id": 2, "username": "admin"}, {"id": 3, "username": "admin"}]}`)
+		default:
+			fmt.Fprint(w, `{"count": 0, "results": []}`)
+		}
+	}})
+
+	rs := NewAWXResourceService[User](server.awxClient(), usersAPIEndpoint, nil).withNameField("username")
+
+	testTable := []struct {
+		name    string
+		scope   map[string]string
+		wantID  int
+		wantErr error
+	}{
+		{name: "jdoe", wantID: 1},
+		{name: "admin", scope: map[string]string{"organization": "2"}, wantID: 3},
+		{name: "admin", wantErr: ErrAmbiguous},
+		{name: "nobody", wantErr: ErrNotFound},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			user, err := rs.GetByName(test.name, test.scope)
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					t.Fatalf("Expecting %s but got %v", test.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetByName err: %s", err)
+			}
+			if user.ID != test.wantID {
+				t.Fatalf("Expecting user %d but got %d", test.wantID, user.ID)
+			}
+		})
+	}
+}
+
+func TestGetByNamedURL(t *testing.T) {
+	server := newTestServer(t, testRoutes{"GET /api/v2/job_templates/deploy app++Default/": func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v2/job_templates/deploy%20app++Default/" {
+			t.Errorf("Expecting the named url to be escaped but got %s", r.URL.EscapedPath())
+		}
+		fmt.Fprint(w, `{"id": 7, "name": "deploy app"}`)
+	}})
+
+	rs := NewAWXResourceService[JobTemplate](server.awxClient(), jobTemplatesAPIEndpoint, nil)
+
+	for _, namedURL := range []string{NamedURL("deploy app", "Default"), "/api/v2/job_templates/deploy%20app++Default/"} {
+		jobTemplate, err := rs.GetByNamedURL(namedURL, nil)
+		if err != nil {
+			t.Fatalf("GetByNamedURL %s err: %s", namedURL, err)
+		}
+		if jobTemplate.ID != 7 {
+			t.Fatalf("Expecting job template 7 but got %d", jobTemplate.ID)
+		}
+	}
+
+	if _, err := rs.GetByNamedURL(NamedURL("unknown", "Default"), nil); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expecting ErrNotFound but got %v", err)
+	}
+	if got := NamedURL("a+b", "Default"); !strings.HasPrefix(got, "a%5B+%5Db++") {
+		t.Fatalf("Expecting the + of a name to be escaped but got %s", got)
+	}
+}
@@ -0,0 +1,224 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const (
+	// RequestIDHeader is the header carrying the request id set by RequestIDMiddleware.
+	RequestIDHeader = "X-Request-Id"
+	// AWXRequestIDHeader is the header carrying the id awx gave to a request, as found in its logs.
+	AWXRequestIDHeader = "X-API-Request-Id"
+)
+
+// maxLoggedBody is the size of the largest body logged by LoggingMiddleware.
+const maxLoggedBody = 64 << 10
+
+// RoundTripFunc sends an http request and returns its response.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc, e.g. to add headers, log or measure requests.
+// Middlewares see every attempt of a request, after the authentication headers are set.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middlewares to the requester chain, the first one registered is the outermost.
+func (r *Requester) Use(middlewares ...Middleware) {
+	r.Middlewares = append(r.Middlewares, middlewares...)
+}
+
+// roundTrip sends req through the middleware chain, the requests are logged on the Logger
+// after every middleware ran.
+func (r *Requester) roundTrip(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(r.Client.Do)
+	if r.Logger != nil {
+		next = LoggingMiddleware(r.Logger)(next)
+	}
+	for i := len(r.Middlewares) - 1; i >= 0; i-- {
+		next = r.Middlewares[i](next)
+	}
+	return next(req)
+}
+
+// LoggingMiddleware logs the method, path, status, duration and awx request id of every request on logger.
+// At debug level, the request and response bodies are logged too, with their secrets redacted.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			debug := logger.Enabled(ctx, slog.LevelDebug)
+
+			attrs := []any{"method", req.Method, "path", req.URL.Path}
+			if id := req.Header.Get(RequestIDHeader); id != "" {
+				attrs = append(attrs, "request_id", id)
+			}
+			if debug && req.GetBody != nil {
+				if body, err := req.GetBody(); err == nil {
+					attrs = append(attrs, "request_body", loggedBody(body))
+				}
+			}
+
+			start := time.Now()
+			resp, err := next(req)
+			attrs = append(attrs, "duration", time.Since(start))
+
+			if err != nil {
+				logger.ErrorContext(ctx, "awx request failed", append(attrs, "error", err)...)
+				return resp, err
+			}
+
+			attrs = append(attrs, "status", resp.StatusCode)
+			if id := resp.Header.Get(AWXRequestIDHeader); id != "" {
+				attrs = append(attrs, "awx_request_id", id)
+			}
+			if debug {
+				var body string
+				body, resp.Body = peekBody(resp.Body)
+				attrs = append(attrs, "response_body", body)
+			}
+
+			level := slog.LevelInfo
+			if resp.StatusCode >= http.StatusBadRequest {
+				level = slog.LevelWarn
+			}
+			logger.Log(ctx, level, "awx request", attrs...)
+			return resp, nil
+		}
+	}
+}
+
+// loggedBody reads and closes body, it returns its redacted content.
+func loggedBody(body io.ReadCloser) string {
+	defer body.Close()
+
+	content, _ := peekBody(body)
+	return content
+}
+
+// peekBody returns the redacted content of body and a reader replaying it.
+// Bodies larger than maxLoggedBody are not read entirely, only their size is logged.
+func peekBody(body io.ReadCloser) (string, io.ReadCloser) {
+	prefix, err := io.ReadAll(io.LimitReader(body, maxLoggedBody+1))
+	replay := struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), body), body}
+
+	switch {
+	case err != nil:
+		return fmt.Sprintf("<unreadable: %s>", err), replay
+	case len(prefix) > maxLoggedBody:
+		// a truncated JSON document cannot be redacted
+		return fmt.Sprintf("<more than %d bytes>", maxLoggedBody), replay
+	}
+	return string(redactBody(prefix)), replay
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying id, sent by RequestIDMiddleware.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request id carried by ctx, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// RequestIDMiddleware sets the RequestIDHeader of every request to the id carried by
+// its context, or to a random id when there is none, to correlate logs across services.
+func RequestIDMiddleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(RequestIDHeader) == "" {
+				id, ok := RequestIDFromContext(req.Context())
+				if !ok {
+					id = newRequestID()
+				}
+				req.Header.Set(RequestIDHeader, id)
+			}
+			return next(req)
+		}
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// DumpOnErrorMiddleware writes the request and the response to w when a request fails
+// or awx answers an error status. Credentials are redacted from the headers and bodies.
+func DumpOnErrorMiddleware(w io.Writer) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			var reqBody []byte
+			if req.GetBody != nil {
+				if body, err := req.GetBody(); err == nil {
+					reqBody, _ = io.ReadAll(body)
+					body.Close()
+				}
+			}
+
+			resp, err := next(req)
+			if err == nil && resp.StatusCode < http.StatusBadRequest {
+				return resp, nil
+			}
+
+			var dump bytes.Buffer
+			fmt.Fprintf(&dump, "%s %s\n", req.Method, req.URL)
+			writeHeaders(&dump, redactHeaders(req.Header))
+			writeBody(&dump, reqBody)
+
+			if err != nil {
+				fmt.Fprintf(&dump, "error: %s\n", err)
+			} else {
+				respBody, readErr := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				// the body is given back to the caller, which still has to decode it
+				resp.Body = io.NopCloser(bytes.NewReader(respBody))
+				if readErr != nil {
+					return resp, readErr
+				}
+
+				fmt.Fprintf(&dump, "\n%s\n", resp.Status)
+				writeHeaders(&dump, redactHeaders(resp.Header))
+				writeBody(&dump, respBody)
+			}
+
+			w.Write(dump.Bytes())
+			return resp, err
+		}
+	}
+}
+
+func writeHeaders(w io.Writer, header http.Header) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Fprintf(w, "%s: %s\n", key, value)
+		}
+	}
+}
+
+func writeBody(w io.Writer, body []byte) {
+	if len(body) > 0 {
+		fmt.Fprintf(w, "\n%s\n", redactBody(body))
+	}
+}
@@ -0,0 +1,124 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Join(r.Header.Values("X-Order"), ",") + "|" + r.Header.Get(RequestIDHeader)))
+	}})
+
+	appendOrder := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				req.Header.Add("X-Order", name)
+				return next(req)
+			}
+		}
+	}
+
+	requester := newTestRequester(server.URL, nil)
+	requester.Use(appendOrder("outer"), RequestIDMiddleware(), appendOrder("inner"))
+
+	var result string
+	ctx := WithRequestID(context.Background(), "correlation-id")
+	if _, err := requester.GetContext(ctx, "/api/v2/ping/", &result, nil); err != nil {
+		t.Fatalf("Get err: %s", err)
+	}
+
+	if result != "outer,inner|correlation-id" {
+		t.Fatalf("Expecting outer,inner|correlation-id but got %s", result)
+	}
+}
+
+func TestDumpOnErrorMiddleware(t *testing.T) {
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"inputs": ["Invalid value."]}`))
+	}})
+
+	var dump bytes.Buffer
+	requester := newTestRequester(server.URL, nil)
+	requester.Use(DumpOnErrorMiddleware(&dump))
+
+	payload := strings.NewReader(`{"name": "vault", "inputs": {"username": "admin", "password": "s3cr3t"}}`) // pragma: allowlist secret
+	_, err := requester.PostJSON("/api/v2/credentials/", payload, new(map[string]interface{}), nil)
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("Expecting an *APIError but got %v", err)
+	}
+	if apiErr.FieldErrors["inputs"][0] != "Invalid value." {
+		t.Fatalf("Expecting the response body to be decoded after the dump but got %v", apiErr.FieldErrors)
+	}
+
+	for _, secret := range []string{"s3cr3t", "Bearer token"} {
+		if strings.Contains(dump.String(), secret) {
+			t.Fatalf("Expecting %s to be redacted but got %s", secret, dump.String())
+		}
+	}
+	for _, expected := range []string{"POST ", "Authorization: REDACTED", `"username":"REDACTED"`, `"name":"vault"`, "400 Bad Request"} {
+		if !strings.Contains(dump.String(), expected) {
+			t.Fatalf("Expecting %s in the dump but got %s", expected, dump.String())
+		}
+	}
+}
+
+func TestRequesterLogger(t *testing.T) {
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(AWXRequestIDHeader, "awx-id")
+		w.Write([]byte(`{"id": 1, "username": "admin", "password": "$encrypted$", "client_secret": "app-secret"}`)) // pragma: allowlist secret
+	}})
+
+	var logs bytes.Buffer
+	requester := newTestRequester(server.URL, nil)
+	requester.Logger = slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	payload := strings.NewReader(`{"username": "admin", "password": "s3cr3t"}`) // pragma: allowlist secret
+	result := map[string]interface{}{}
+	if _, err := requester.PostJSON("/api/v2/users/", payload, &result, nil); err != nil {
+		t.Fatalf("Post err: %s", err)
+	}
+	if result["client_secret"] != "app-secret" {
+		t.Fatalf("Expecting the response to be decoded after logging but got %v", result)
+	}
+
+	requester.Logger.Info("authenticator", "auth", &BasicAuth{Username: "admin", Password: "s3cr3t"}) // pragma: allowlist secret
+
+	for _, secret := range []string{"s3cr3t", "app-secret", "$encrypted$"} {
+		if strings.Contains(logs.String(), secret) {
+			t.Fatalf("Expecting %s to be redacted but got %s", secret, logs.String())
+		}
+	}
+	for _, expected := range []string{`"method":"POST"`, `"path":"/api/v2/users/"`, `"status":200`, `"awx_request_id":"awx-id"`, `"duration"`, `\"username\":\"admin\"`} {
+		if !strings.Contains(logs.String(), expected) {
+			t.Fatalf("Expecting %s in the logs but got %s", expected, logs.String())
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	testTable := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "json", body: `{"name": "vault", "inputs": {"password": "s3cr3t"}}`, want: `{"inputs":{"password":"REDACTED"},"name":"vault"}`},   // pragma: allowlist secret
+		{name: "form", body: "grant_type=password&username=admin&password=s3cr3t", want: "grant_type=password&password=REDACTED&username=admin"}, // pragma: allowlist secret
+		{name: "text", body: "PLAY [all] password=s3cr3t\n", want: "<27 bytes, neither JSON nor a form>"},                                        // pragma: allowlist secret
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			if got := string(redactBody([]byte(test.body))); got != test.want {
+				t.Fatalf("Expecting %q but got %q", test.want, got)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package awx
 
+import "context"
+
 // NotificationTemplatesService implements awx projects apis.
 type NotificationTemplateService interface {
 	List(params map[string]string) ([]*NotificationTemplate, *ResultsList[NotificationTemplate], error)
@@ -7,6 +9,34 @@ type NotificationTemplateService interface {
 	Create(data map[string]interface{}, params map[string]string) (*NotificationTemplate, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*NotificationTemplate, error)
 	Delete(id int) (*NotificationTemplate, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*NotificationTemplate, *ResultsList[NotificationTemplate], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*NotificationTemplate, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*NotificationTemplate, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*NotificationTemplate, error)
+	DeleteContext(ctx context.Context, id int) (*NotificationTemplate, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*NotificationTemplate, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[NotificationTemplate]
+	ListQuery(ctx context.Context, q *Query) ([]*NotificationTemplate, *ResultsList[NotificationTemplate], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*NotificationTemplate, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[NotificationTemplate]
+	CreateWith(ctx context.Context, req CreateRequest[NotificationTemplate], params map[string]string) (*NotificationTemplate, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[NotificationTemplate], params map[string]string) (*NotificationTemplate, error)
+	GetByName(name string, scope map[string]string) (*NotificationTemplate, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*NotificationTemplate, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*NotificationTemplate, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*NotificationTemplate, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[NotificationTemplate]) (*EnsureResult[NotificationTemplate], error)
+	Copy(id int, newName string) (*NotificationTemplate, error)
+	CopyContext(ctx context.Context, id int, newName string) (*NotificationTemplate, error)
+	CanCopy(id int) (*CopyCapability, error)
+	CanCopyContext(ctx context.Context, id int) (*CopyCapability, error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*NotificationTemplate, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type notificationTemplateServiceHTTP struct {
@@ -21,3 +51,50 @@ type ListNotificationTemplatesResponse struct {
 }
 
 const notificationTemplatesAPIEndpoint = "/api/v2/notification_templates/"
+
+// notificationTypes are the notification types supported by awx.
+var notificationTypes = []string{
+	"email", "grafana", "irc", "mattermost", "pagerduty", "rocketchat", "slack", "twilio", "webhook",
+}
+
+// NotificationTemplateCreateRequest holds the fields of a new notification template, whose
+// configuration depends on its notification type.
+type NotificationTemplateCreateRequest struct {
+	Name                      string                 `json:"name"`
+	Description               string                 `json:"description,omitempty"`
+	Organization              int                    `json:"organization"`
+	NotificationType          string                 `json:"notification_type"`
+	NotificationConfiguration map[string]interface{} `json:"notification_configuration,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *NotificationTemplateCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("organization", r.Organization != 0),
+		required("notification_type", r.NotificationType != ""),
+		oneOf("notification_type", r.NotificationType, notificationTypes...),
+	)
+}
+
+func (*NotificationTemplateCreateRequest) createRequest(*NotificationTemplate) {}
+
+// NotificationTemplateUpdateRequest changes some fields of a notification template, the nil ones are not sent.
+type NotificationTemplateUpdateRequest struct {
+	Name                      *string                `json:"name,omitempty"`
+	Description               *string                `json:"description,omitempty"`
+	Organization              *int                   `json:"organization,omitempty"`
+	NotificationType          *string                `json:"notification_type,omitempty"`
+	NotificationConfiguration map[string]interface{} `json:"notification_configuration,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *NotificationTemplateUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		notEmpty("notification_type", r.NotificationType),
+		oneOf("notification_type", deref(r.NotificationType), notificationTypes...),
+	)
+}
+
+func (*NotificationTemplateUpdateRequest) updateRequest(*NotificationTemplate) {}
@@ -0,0 +1,294 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OAuth2 grant types supported by OAuth2Auth.
+const (
+	GrantTypePassword          = "password"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// DefaultOAuth2RefreshBefore is how long before its expiry a token is renewed by default.
+const DefaultOAuth2RefreshBefore = time.Minute
+
+const (
+	oauth2TokenEndpoint  = "/api/o/token/"
+	oauth2RevokeEndpoint = "/api/o/revoke_token/"
+)
+
+// OAuth2Auth authenticates with OAuth2 tokens obtained from the awx `/api/o/token/` endpoint.
+// Tokens are requested on first use, renewed before they expire and revoked by Close.
+type OAuth2Auth struct {
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	GrantType    string
+	// Username and Password are used by the password grant.
+	Username string
+	Password string
+	// Scope is either `read` or `write`, awx defaults to `write`.
+	Scope string
+	// Client sends the token requests, http.DefaultClient when nil.
+	Client *http.Client
+	// RefreshBefore overrides DefaultOAuth2RefreshBefore.
+	RefreshBefore time.Duration
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiry       time.Time
+	// pending is the token request in flight, nil when there is none.
+	pending *oauth2Request
+}
+
+// oauth2Request is a token request shared by the callers of Token waiting for it,
+// token and err are set before done is closed.
+type oauth2Request struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// oauth2TokenResponse represents the awx `/api/o/token/` endpoint response.
+type oauth2TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+// NewPasswordOAuth2Auth creates an OAuth2Auth using the password grant of app on behalf of username.
+func NewPasswordOAuth2Auth(baseURL string, app *Application, username, password string) *OAuth2Auth {
+	return &OAuth2Auth{
+		BaseURL:      baseURL,
+		ClientID:     app.ClientID,
+		ClientSecret: app.ClientSecret,
+		GrantType:    GrantTypePassword,
+		Username:     username,
+		Password:     password, // pragma: allowlist secret
+	}
+}
+
+// NewClientCredentialsOAuth2Auth creates an OAuth2Auth using the client credentials grant of app.
+func NewClientCredentialsOAuth2Auth(baseURL string, app *Application) *OAuth2Auth {
+	return &OAuth2Auth{
+		BaseURL:      baseURL,
+		ClientID:     app.ClientID,
+		ClientSecret: app.ClientSecret,
+		GrantType:    GrantTypeClientCredentials,
+	}
+}
+
+// Authenticate adds a valid access token as a bearer token.
+func (oa *OAuth2Auth) Authenticate(ctx context.Context, r *http.Request) error {
+	token, err := oa.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+// LogValue implements slog.LogValuer, the secrets are redacted.
+func (oa *OAuth2Auth) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("base_url", oa.BaseURL),
+		slog.String("client_id", oa.ClientID),
+		slog.String("grant_type", oa.GrantType),
+		slog.String("username", oa.Username),
+	)
+}
+
+// Token returns a valid access token, requesting or refreshing it if needed. A single token request
+// is sent at a time, the concurrent callers wait for its outcome without holding the lock.
+func (oa *OAuth2Auth) Token(ctx context.Context) (string, error) {
+	refreshBefore := oa.RefreshBefore
+	if refreshBefore <= 0 {
+		refreshBefore = DefaultOAuth2RefreshBefore
+	}
+
+	for {
+		oa.mu.Lock()
+		if oa.accessToken != "" && time.Until(oa.expiry) > refreshBefore {
+			token := oa.accessToken
+			oa.mu.Unlock()
+			return token, nil
+		}
+
+		if pending := oa.pending; pending != nil {
+			oa.mu.Unlock()
+			select {
+			case <-pending.done:
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			// the request was canceled by the context of another caller, try again with ours
+			if errors.Is(pending.err, context.Canceled) || errors.Is(pending.err, context.DeadlineExceeded) {
+				continue
+			}
+			return pending.token, pending.err
+		}
+
+		pending := &oauth2Request{done: make(chan struct{})}
+		oa.pending = pending
+		refreshToken := oa.refreshToken
+		oa.mu.Unlock()
+
+		result, err := oa.obtainToken(ctx, refreshToken)
+
+		oa.mu.Lock()
+		if err == nil {
+			oa.accessToken = result.AccessToken
+			oa.refreshToken = result.RefreshToken
+			oa.expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+			pending.token = result.AccessToken
+		}
+		pending.err = err
+		oa.pending = nil
+		oa.mu.Unlock()
+		close(pending.done)
+
+		return pending.token, err
+	}
+}
+
+// obtainToken refreshes the token with refreshToken, or requests a new one with the grant of oa.
+func (oa *OAuth2Auth) obtainToken(ctx context.Context, refreshToken string) (*oauth2TokenResponse, error) {
+	if refreshToken != "" {
+		result, err := oa.requestToken(ctx, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {refreshToken},
+		})
+		if err == nil {
+			return result, nil
+		}
+		// the refresh token may have been revoked or expired, fallback on a new grant
+	}
+
+	form := url.Values{"grant_type": {oa.GrantType}}
+	switch oa.GrantType {
+	case GrantTypePassword:
+		form.Set("username", oa.Username)
+		form.Set("password", oa.Password)
+	case GrantTypeClientCredentials:
+	default:
+		return nil, fmt.Errorf("awx: unsupported OAuth2 grant type %q", oa.GrantType)
+	}
+
+	return oa.requestToken(ctx, form)
+}
+
+// requestToken posts form to the token endpoint and returns the obtained token.
+func (oa *OAuth2Auth) requestToken(ctx context.Context, form url.Values) (*oauth2TokenResponse, error) {
+	if oa.Scope != "" {
+		form.Set("scope", oa.Scope)
+	}
+
+	result := new(oauth2TokenResponse)
+	if err := oa.post(ctx, oauth2TokenEndpoint, form, result); err != nil {
+		return nil, err
+	}
+
+	if result.AccessToken == "" {
+		return nil, errors.New("awx: no access token in OAuth2 token response")
+	}
+	return result, nil
+}
+
+// Reauthenticate drops the current access token, a new one is requested on the next call.
+func (oa *OAuth2Auth) Reauthenticate(ctx context.Context) error {
+	oa.mu.Lock()
+	defer oa.mu.Unlock()
+
+	oa.accessToken = ""
+	oa.expiry = time.Time{}
+	return nil
+}
+
+// Close revokes the current access and refresh tokens, it implements io.Closer.
+func (oa *OAuth2Auth) Close() error {
+	return oa.Revoke(context.Background())
+}
+
+// Revoke revokes the current access and refresh tokens, the next request obtains a new token.
+// The lock is not held while the revocations are sent.
+func (oa *OAuth2Auth) Revoke(ctx context.Context) error {
+	oa.mu.Lock()
+	accessToken, refreshToken := oa.accessToken, oa.refreshToken
+	oa.mu.Unlock()
+
+	// the refresh token first, it would otherwise still obtain new access tokens
+	tokens := []struct{ token, hint string }{
+		{token: refreshToken, hint: "refresh_token"},
+		{token: accessToken, hint: "access_token"},
+	}
+	for _, t := range tokens {
+		if t.token == "" {
+			continue
+		}
+		if err := oa.post(ctx, oauth2RevokeEndpoint, url.Values{"token": {t.token}, "token_type_hint": {t.hint}}, nil); err != nil {
+			return err
+		}
+	}
+
+	oa.mu.Lock()
+	defer oa.mu.Unlock()
+
+	// a token obtained meanwhile was not revoked, it is kept
+	if oa.accessToken == accessToken {
+		oa.accessToken = ""
+		oa.refreshToken = ""
+		oa.expiry = time.Time{}
+	}
+	return nil
+}
+
+// post sends an url encoded form authenticated with the application credentials.
+func (oa *OAuth2Auth) post(ctx context.Context, endpoint string, form url.Values, responseStruct interface{}) error {
+	if oa.ClientSecret == "" {
+		// public applications authenticate with their client id only
+		form.Set("client_id", oa.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oa.BaseURL+endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if oa.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(oa.ClientID), url.QueryEscape(oa.ClientSecret))
+	}
+
+	client := oa.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if responseStruct == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(responseStruct)
+}
@@ -0,0 +1,121 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOAuth2AuthLifecycle(t *testing.T) {
+	var mu sync.Mutex
+	grants := map[string]int{}
+	revoked := []string{}
+
+	// clientForm checks the application credentials, then parses the form under the lock
+	clientForm := func(handler http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if user, _, ok := r.BasicAuth(); !ok || user != "client-id" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			r.ParseForm()
+			handler(w, r)
+		}
+	}
+	server := newTestServer(t, testRoutes{
+		oauth2TokenEndpoint: clientForm(func(w http.ResponseWriter, r *http.Request) {
+			grant := r.PostForm.Get("grant_type")
+			grants[grant]++
+			writeJSON(w, oauth2TokenResponse{
+				AccessToken:  fmt.Sprintf("%s-%d", grant, grants[grant]),
+				RefreshToken: "refresh",
+				// expires right away so that the next call refreshes it
+				ExpiresIn: 30,
+			})
+		}),
+		oauth2RevokeEndpoint: clientForm(func(w http.ResponseWriter, r *http.Request) {
+			revoked = append(revoked, r.PostForm.Get("token"))
+		}),
+	})
+
+	app := &Application{ClientID: "client-id", ClientSecret: "client-secret"} // pragma: allowlist secret
+	auth := NewPasswordOAuth2Auth(server.URL, app, "admin", "password")       // pragma: allowlist secret
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err := auth.Authenticate(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer password-1" {
+		t.Errorf("Expecting the password grant token but got %q", got)
+	}
+
+	token, err := auth.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "refresh_token-1" {
+		t.Errorf("Expecting the token to be refreshed before its expiry but got %q", token)
+	}
+
+	if err := auth.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(revoked, []string{"refresh", "refresh_token-1"}) {
+		t.Errorf("Expecting the refresh and access tokens to be revoked but got %v", revoked)
+	}
+}
+
+func TestOAuth2AuthConcurrentToken(t *testing.T) {
+	var requests atomic.Int64
+	release := make(chan struct{})
+	server := newTestServer(t, testRoutes{oauth2TokenEndpoint: func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		writeJSON(w, oauth2TokenResponse{AccessToken: "token", ExpiresIn: 3600})
+	}})
+
+	app := &Application{ClientID: "client-id", ClientSecret: "client-secret"} // pragma: allowlist secret
+	auth := NewClientCredentialsOAuth2Auth(server.URL, app)
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 5)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], _ = auth.Token(context.Background())
+		}(i)
+	}
+
+	// the lock is not held while the token is requested
+	reauthenticated := make(chan struct{})
+	go func() {
+		auth.Reauthenticate(context.Background())
+		close(reauthenticated)
+	}()
+	select {
+	case <-reauthenticated:
+	case <-time.After(time.Second):
+		t.Fatalf("Expecting Reauthenticate not to wait for the token request")
+	}
+
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 1 {
+		t.Fatalf("Expecting a single token request but got %d", requests.Load())
+	}
+	for _, token := range tokens {
+		if token != "token" {
+			t.Fatalf("Expecting every caller to get the token but got %v", tokens)
+		}
+	}
+}
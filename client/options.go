@@ -0,0 +1,166 @@
+package awx
+
+import (
+	"crypto/tls"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// DefaultUserAgent is the User-Agent header sent when none is configured.
+const DefaultUserAgent = "goawx"
+
+// Option configures the awx handler created by New.
+type Option func(*options)
+
+type options struct {
+	authenticator      Authenticator
+	httpClient         *http.Client
+	userAgent          string
+	timeout            time.Duration
+	tlsConfig          *tls.Config
+	retryPolicy        *RetryPolicy
+	rateLimiter        *RateLimiter
+	concurrencyLimiter *ConcurrencyLimiter
+	skipPing           bool
+	logger             *slog.Logger
+	headers            http.Header
+	middlewares        []Middleware
+}
+
+// WithBasicAuth authenticates with a username and a password.
+func WithBasicAuth(username, password string) Option {
+	return func(o *options) {
+		o.authenticator = &BasicAuth{Username: username, Password: password} // pragma: allowlist secret
+	}
+}
+
+// WithToken authenticates with a personal or application token.
+func WithToken(token string) Option {
+	return func(o *options) {
+		o.authenticator = &TokenAuth{Token: token}
+	}
+}
+
+// WithAuthenticator authenticates with the given Authenticator.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(o *options) {
+		o.authenticator = authenticator
+	}
+}
+
+// WithHTTPClient sends the requests through client instead of http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithUserAgent overrides DefaultUserAgent, an empty userAgent leaves the one of the http client.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the timeout of every http request, retries excluded.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the http transport,
+// e.g. to trust a private certificate authority.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithRetryPolicy retries transient failures according to policy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithRateLimit limits the requests to requestsPerSecond, with bursts of up to burst requests.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(o *options) {
+		o.rateLimiter = NewRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithMaxInFlight caps the number of requests in flight.
+func WithMaxInFlight(maxInFlight int) Option {
+	return func(o *options) {
+		o.concurrencyLimiter = NewConcurrencyLimiter(maxInFlight)
+	}
+}
+
+// WithSkipPing does not ping the awx server when creating the handler.
+func WithSkipPing() Option {
+	return func(o *options) {
+		o.skipPing = true
+	}
+}
+
+// WithLogger sets the logger used by the requester.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithHeader adds a header to every request.
+func WithHeader(key, value string) Option {
+	return func(o *options) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// WithMiddleware wraps every request with middlewares, the first one is the outermost.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *options) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// buildHTTPClient returns the http client honoring the timeout and TLS options,
+// the client given by WithHTTPClient is copied and never modified.
+func (o *options) buildHTTPClient() (*http.Client, error) {
+	client := o.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if o.timeout == 0 && o.tlsConfig == nil {
+		return client, nil
+	}
+
+	custom := *client
+	if o.timeout != 0 {
+		custom.Timeout = o.timeout
+	}
+
+	if o.tlsConfig != nil {
+		var transport *http.Transport
+		switch t := custom.Transport.(type) {
+		case nil:
+			transport = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			transport = t.Clone()
+		default:
+			return nil, errors.New("awx: a TLS config can only be set on an *http.Transport")
+		}
+		transport.TLSClientConfig = o.tlsConfig
+		custom.Transport = transport
+	}
+
+	return &custom, nil
+}
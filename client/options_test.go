@@ -0,0 +1,196 @@
+package awx
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNewOptions(t *testing.T) {
+	var headers http.Header
+	server := newTestServer(t, testRoutes{pingAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		w.Write([]byte(`{"version": "23.0.0"}`))
+	}})
+
+	testTable := []struct {
+		name          string
+		opts          []Option
+		wantRequests  int
+		wantUserAgent string
+		wantHeader    string
+	}{
+		{name: "defaults", wantRequests: 1, wantUserAgent: DefaultUserAgent},
+		{name: "user agent", opts: []Option{WithUserAgent("my-tool/1.0")}, wantRequests: 1, wantUserAgent: "my-tool/1.0"},
+		{name: "header", opts: []Option{WithHeader("X-Team", "platform")}, wantRequests: 1, wantUserAgent: DefaultUserAgent, wantHeader: "platform"},
+		{name: "skip ping", opts: []Option{WithSkipPing()}, wantRequests: 0},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			headers = nil
+			before := server.Requests()
+
+			if _, err := New(server.URL, test.opts...); err != nil {
+				t.Fatalf("New err: %s", err)
+			}
+
+			if got := server.Requests() - before; got != test.wantRequests {
+				t.Fatalf("Expecting %d requests but got %d", test.wantRequests, got)
+			}
+			if test.wantRequests == 0 {
+				return
+			}
+			if got := headers.Get("User-Agent"); got != test.wantUserAgent {
+				t.Fatalf("Expecting the User-Agent %q but got %q", test.wantUserAgent, got)
+			}
+			if got := headers.Get("X-Team"); got != test.wantHeader {
+				t.Fatalf("Expecting the X-Team header %q but got %q", test.wantHeader, got)
+			}
+		})
+	}
+}
+
+func TestNewAWXUserAgent(t *testing.T) {
+	var userAgent string
+	server := newTestServer(t, testRoutes{pingAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"version": "23.0.0"}`))
+	}})
+
+	if _, err := NewAWXToken(server.URL, "token", nil); err != nil { // pragma: allowlist secret
+		t.Fatalf("NewAWXToken err: %s", err)
+	}
+	if userAgent != "Go-http-client/1.1" {
+		t.Fatalf("Expecting the User-Agent of the http client but got %q", userAgent)
+	}
+}
+
+func TestNewHTTPClientOptions(t *testing.T) {
+	custom := &http.Client{Transport: &http.Transport{}}
+	wrapped := &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}
+
+	testTable := []struct {
+		name    string
+		opts    []Option
+		check   func(t *testing.T, client *http.Client)
+		wantErr bool
+	}{
+		{
+			name: "nil http client",
+			opts: []Option{WithHTTPClient(nil)},
+			check: func(t *testing.T, client *http.Client) {
+				if client != http.DefaultClient {
+					t.Fatalf("Expecting http.DefaultClient but got %+v", client)
+				}
+			},
+		},
+		{
+			name: "http client",
+			opts: []Option{WithHTTPClient(custom)},
+			check: func(t *testing.T, client *http.Client) {
+				if client != custom {
+					t.Fatalf("Expecting the given http client but got %+v", client)
+				}
+			},
+		},
+		{
+			name: "timeout",
+			opts: []Option{WithHTTPClient(custom), WithTimeout(time.Minute)},
+			check: func(t *testing.T, client *http.Client) {
+				if client == custom || client.Timeout != time.Minute || custom.Timeout != 0 {
+					t.Fatalf("Expecting a copy of the http client with a timeout but got %+v", client)
+				}
+			},
+		},
+		{
+			name: "tls config",
+			opts: []Option{WithTLSConfig(&tls.Config{ServerName: "awx"})},
+			check: func(t *testing.T, client *http.Client) {
+				transport, ok := client.Transport.(*http.Transport)
+				if !ok || transport.TLSClientConfig.ServerName != "awx" {
+					t.Fatalf("Expecting a transport with the TLS config but got %+v", client.Transport)
+				}
+				if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil && config.ServerName == "awx" {
+					t.Fatalf("Expecting the default transport not to be modified")
+				}
+			},
+		},
+		{
+			name:    "tls config on a custom round tripper",
+			opts:    []Option{WithHTTPClient(wrapped), WithTLSConfig(&tls.Config{})},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := New("https://awx.example.com", append(test.opts, WithSkipPing())...)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("Expecting an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("New err: %s", err)
+			}
+			test.check(t, client.Client().Requester.Client)
+		})
+	}
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	server := newTestTLSServer(t, testRoutes{pingAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version": "23.0.0"}`))
+	}})
+
+	if _, err := New(server.URL); err == nil {
+		t.Fatalf("Expecting the self-signed certificate to be rejected")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	if _, err := New(server.URL, WithTLSConfig(&tls.Config{RootCAs: pool})); err != nil {
+		t.Fatalf("Expecting the certificate to be trusted but got %s", err)
+	}
+}
+
+func TestNewTimeout(t *testing.T) {
+	server := newTestServer(t, testRoutes{pingAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}})
+
+	if _, err := New(server.URL, WithTimeout(20*time.Millisecond)); err == nil {
+		t.Fatalf("Expecting the ping to time out")
+	}
+}
+
+func TestAWXCloseZeroValue(t *testing.T) {
+	var nilAWX *AWX
+	for _, a := range []*AWX{nilAWX, {}, {client: &Client{}}} {
+		if err := a.Close(); err != nil {
+			t.Fatalf("Expecting no error but got %s", err)
+		}
+	}
+
+	client, err := New("https://awx.example.com", WithSkipPing(), WithAuthenticator(AuthenticatorFunc(func(context.Context, *http.Request) error { return nil })))
+	if err != nil {
+		t.Fatalf("New err: %s", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Expecting no error without a closer but got %s", err)
+	}
+}
@@ -1,10 +1,6 @@
 package awx
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-)
+import "context"
 
 // OrganizationsService implements awx organizations apis.
 type OrganizationService interface {
@@ -13,8 +9,35 @@ type OrganizationService interface {
 	Create(data map[string]interface{}, params map[string]string) (*Organization, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Organization, error)
 	Delete(id int) (*Organization, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Organization, *ResultsList[Organization], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Organization, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Organization, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error)
+	DeleteContext(ctx context.Context, id int) (*Organization, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Organization, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Organization]
+	ListQuery(ctx context.Context, q *Query) ([]*Organization, *ResultsList[Organization], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Organization, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Organization]
+	CreateWith(ctx context.Context, req CreateRequest[Organization], params map[string]string) (*Organization, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Organization], params map[string]string) (*Organization, error)
+	GetByName(name string, scope map[string]string) (*Organization, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Organization, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Organization, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Organization, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Organization]) (*EnsureResult[Organization], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Organization, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 	DisAssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error)
+	DisAssociateGalaxyCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error)
 	AssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error)
+	AssociateGalaxyCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error)
+	GalaxyCredentialsAssociation() *Association[Organization, Credential]
 }
 
 type organizationServiceHTTP struct {
@@ -30,27 +53,50 @@ type ListOrganizationsResponse struct {
 
 const organizationsAPIEndpoint = "/api/v2/organizations/"
 
+// OrganizationCreateRequest holds the fields of a new organization, only its name is required.
+type OrganizationCreateRequest struct {
+	Name               string `json:"name"`
+	Description        string `json:"description,omitempty"`
+	MaxHosts           int    `json:"max_hosts,omitempty"`
+	DefaultEnvironment int    `json:"default_environment,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *OrganizationCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+	)
+}
+
+func (*OrganizationCreateRequest) createRequest(*Organization) {}
+
+// OrganizationUpdateRequest renames an organization or changes its limits and default environment.
+type OrganizationUpdateRequest struct {
+	Name               *string `json:"name,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	MaxHosts           *int    `json:"max_hosts,omitempty"`
+	DefaultEnvironment *int    `json:"default_environment,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *OrganizationUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+	)
+}
+
+func (*OrganizationUpdateRequest) updateRequest(*Organization) {}
+
 // DisAssociateGalaxyCredentials remove Credentials form an awx job template
 func (p *organizationServiceHTTP) DisAssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+	return p.DisAssociateGalaxyCredentialsContext(context.Background(), id, data, params)
+}
+
+// DisAssociateGalaxyCredentialsContext is the context-aware version of DisAssociateGalaxyCredentials.
+func (p *organizationServiceHTTP) DisAssociateGalaxyCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
 	result := new(Organization)
-	endpoint := fmt.Sprintf("%s%d/galaxy_credentials/", organizationsAPIEndpoint, id)
 	data["disassociate"] = true
-	mandatoryFields := []string{"id", "disassociate"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := p.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	if err := p.GalaxyCredentialsAssociation().send(ctx, id, data, result); err != nil {
 		return nil, err
 	}
 
@@ -59,28 +105,21 @@ func (p *organizationServiceHTTP) DisAssociateGalaxyCredentials(id int, data map
 
 // AssociateGalaxyCredentials adding credentials to Organization.
 func (p *organizationServiceHTTP) AssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
-	result := new(Organization)
+	return p.AssociateGalaxyCredentialsContext(context.Background(), id, data, params)
+}
 
-	endpoint := fmt.Sprintf("%s%d/galaxy_credentials/", organizationsAPIEndpoint, id)
+// AssociateGalaxyCredentialsContext is the context-aware version of AssociateGalaxyCredentials.
+func (p *organizationServiceHTTP) AssociateGalaxyCredentialsContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+	result := new(Organization)
 	data["associate"] = true
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := p.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	if err := p.GalaxyCredentialsAssociation().send(ctx, id, data, result); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
+
+// GalaxyCredentialsAssociation returns the Association of the galaxy credentials of the organizations.
+func (p *organizationServiceHTTP) GalaxyCredentialsAssociation() *Association[Organization, Credential] {
+	return NewAssociation[Organization, Credential](p.client, "galaxy_credentials")
+}
@@ -0,0 +1,179 @@
+package awx
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// MaxPageSize is the largest page size accepted by awx.
+const MaxPageSize = 200
+
+// Pager lazily iterates over every object of a list endpoint, following the `next` page links.
+// Pages are only fetched when needed: stop calling Next to stop fetching.
+//
+//	pager := client.HostService.Iterate(ctx, map[string]string{"page_size": "200"})
+//	for pager.Next() {
+//		host := pager.Value()
+//	}
+//	if err := pager.Err(); err != nil {
+//		return err
+//	}
+type Pager[T any] struct {
+	ctx    context.Context
+	client *Client
+
+	endpoint string
+	query    url.Values
+
+	page    []*T
+	current *T
+	count   int
+	started bool
+	err     error
+}
+
+// NewPager creates a Pager over the objects of endpoint, which may be any list or sub-list endpoint
+// such as `/api/v2/teams/1/users/`. params filters the list, `page_size` sets the size of the pages.
+func NewPager[T any](ctx context.Context, client *Client, endpoint string, params map[string]string) *Pager[T] {
+	return newPager[T](ctx, client, endpoint, mapValues(params))
+}
+
+// NewQueryPager creates a Pager over the objects of endpoint matching q.
+func NewQueryPager[T any](ctx context.Context, client *Client, endpoint string, q *Query) *Pager[T] {
+	return newPager[T](ctx, client, endpoint, q.Values())
+}
+
+func newPager[T any](ctx context.Context, client *Client, endpoint string, query url.Values) *Pager[T] {
+	return &Pager[T]{
+		ctx:      ctx,
+		client:   client,
+		endpoint: endpoint,
+		query:    query,
+	}
+}
+
+// Next advances to the next object, fetching the next page if needed.
+// It returns false when there are no more objects or an error occurred.
+func (p *Pager[T]) Next() bool {
+	for len(p.page) == 0 {
+		if p.err != nil || (p.started && p.endpoint == "") {
+			p.current = nil
+			return false
+		}
+		p.err = p.fetch()
+	}
+
+	p.current, p.page = p.page[0], p.page[1:]
+	return true
+}
+
+// Value returns the current object.
+func (p *Pager[T]) Value() *T {
+	return p.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Count returns the total number of objects reported by awx, once the first page is fetched.
+func (p *Pager[T]) Count() int {
+	return p.count
+}
+
+// fetch gets the next page and moves the endpoint to the page after it.
+func (p *Pager[T]) fetch() error {
+	p.started = true
+
+	result := new(ResultsList[T])
+	resp, err := p.client.Requester.getJSONQueryContext(p.ctx, p.endpoint, result, p.query)
+	if err != nil {
+		return err
+	}
+	if err := CheckResponse(resp); err != nil {
+		return err
+	}
+
+	p.page = result.Results
+	p.count = result.Count
+	p.endpoint, p.query, err = nextPage(p.client, result.Pagination)
+	return err
+}
+
+// nextPage returns the endpoint and the query of the page following pagination, an empty
+// endpoint on the last page. The `next` link already carries the filters of the first request,
+// and the path prefix of the server which is stripped as for related links.
+func nextPage(client *Client, pagination Pagination) (string, url.Values, error) {
+	next, _ := pagination.Next.(string)
+	if next == "" {
+		return "", nil, nil
+	}
+
+	return relatedEndpoint(client, next)
+}
+
+// ListAll returns every object of endpoint, fetching all its pages.
+// Unless params sets `page_size`, pages of MaxPageSize objects are requested.
+func ListAll[T any](ctx context.Context, client *Client, endpoint string, params map[string]string) ([]*T, error) {
+	return listAll[T](ctx, client, endpoint, mapValues(params))
+}
+
+// ListAllQuery returns every object of endpoint matching q, fetching all its pages.
+func ListAllQuery[T any](ctx context.Context, client *Client, endpoint string, q *Query) ([]*T, error) {
+	return listAll[T](ctx, client, endpoint, q.Values())
+}
+
+func listAll[T any](ctx context.Context, client *Client, endpoint string, query url.Values) ([]*T, error) {
+	if !query.Has("page_size") {
+		query.Set("page_size", strconv.Itoa(MaxPageSize))
+	}
+	pager := newPager[T](ctx, client, endpoint, query)
+
+	results := make([]*T, 0)
+	for pager.Next() {
+		results = append(results, pager.Value())
+	}
+	return results, pager.Err()
+}
+
+// ListAll returns every object matching params, fetching all the pages.
+func (rs *AWXResourceService[T]) ListAll(ctx context.Context, params map[string]string) ([]*T, error) {
+	return ListAll[T](ctx, rs.client, rs.basePath, params)
+}
+
+// Iterate returns a Pager lazily iterating over the objects matching params.
+func (rs *AWXResourceService[T]) Iterate(ctx context.Context, params map[string]string) *Pager[T] {
+	return NewPager[T](ctx, rs.client, rs.basePath, params)
+}
+
+// ListQuery returns the first page of the objects matching q.
+func (rs *AWXResourceService[T]) ListQuery(ctx context.Context, q *Query) ([]*T, *ResultsList[T], error) {
+	result := new(ResultsList[T])
+	resp, err := rs.client.Requester.getJSONQueryContext(ctx, rs.basePath, result, q.Values())
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// ListAllQuery returns every object matching q, fetching all the pages.
+func (rs *AWXResourceService[T]) ListAllQuery(ctx context.Context, q *Query) ([]*T, error) {
+	return ListAllQuery[T](ctx, rs.client, rs.basePath, q)
+}
+
+// IterateQuery returns a Pager lazily iterating over the objects matching q.
+func (rs *AWXResourceService[T]) IterateQuery(ctx context.Context, q *Query) *Pager[T] {
+	return NewQueryPager[T](ctx, rs.client, rs.basePath, q)
+}
+
+// allPages reports whether every page is requested, a nil request only asks for the first page.
+func (p *PaginationRequest) allPages() bool {
+	return p != nil && p.AllPages != nil && *p.AllPages
+}
@@ -0,0 +1,123 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// newPagedServer serves total hosts, in pages of `page_size` hosts, on every endpoint.
+func newPagedServer(t *testing.T, total int) *testServer {
+	t.Helper()
+
+	return newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("name__startswith") != "web" {
+			t.Errorf("Expecting the filter to be kept on every page but got %s", r.URL.RawQuery)
+		}
+		page, _ := strconv.Atoi(query.Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		size, _ := strconv.Atoi(query.Get("page_size"))
+		if size == 0 {
+			size = 25
+		}
+
+		next := "null"
+		if page*size < total {
+			next = fmt.Sprintf(`"%s?name__startswith=web&page=%d&page_size=%d"`, r.URL.Path, page+1, size)
+		}
+
+		results := ""
+		for id := (page-1)*size + 1; id <= page*size && id <= total; id++ {
+			if results != "" {
+				results += ","
+			}
+			results += fmt.Sprintf(`{"id": %d}`, id)
+		}
+		fmt.Fprintf(w, `{"count": %d, "next": %s, "results": [%s]}`, total, next, results)
+	}})
+}
+
+func TestListAll(t *testing.T) {
+	testTable := []struct {
+		name         string
+		total        int
+		params       map[string]string
+		wantRequests int
+	}{
+		{name: "empty", total: 0, params: map[string]string{}, wantRequests: 1},
+		{name: "max page size by default", total: 450, params: map[string]string{}, wantRequests: 3},
+		{name: "page size", total: 45, params: map[string]string{"page_size": "10"}, wantRequests: 5},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			server := newPagedServer(t, test.total)
+			rs := NewAWXResourceService[Host](server.awxClient(), hostsAPIEndpoint, nil)
+
+			test.params["name__startswith"] = "web"
+			hosts, err := rs.ListAll(context.Background(), test.params)
+			if err != nil {
+				t.Fatalf("ListAll err: %s", err)
+			}
+
+			if len(hosts) != test.total {
+				t.Fatalf("Expecting %d hosts but got %d", test.total, len(hosts))
+			}
+			for i, host := range hosts {
+				if host.ID != i+1 {
+					t.Fatalf("Expecting host %d at index %d but got %d", i+1, i, host.ID)
+				}
+			}
+			if server.Requests() != test.wantRequests {
+				t.Fatalf("Expecting %d requests but got %d", test.wantRequests, server.Requests())
+			}
+		})
+	}
+}
+
+func TestPagerEarlyTermination(t *testing.T) {
+	server := newPagedServer(t, 100)
+	client := server.awxClient()
+
+	pager := NewPager[Host](context.Background(), client, "/api/v2/groups/1/hosts/", map[string]string{"name__startswith": "web", "page_size": "10"})
+	for pager.Next() {
+		if pager.Value().ID == 15 {
+			break
+		}
+	}
+
+	if err := pager.Err(); err != nil {
+		t.Fatalf("Pager err: %s", err)
+	}
+	if pager.Count() != 100 {
+		t.Fatalf("Expecting a count of 100 but got %d", pager.Count())
+	}
+	if server.Requests() != 2 {
+		t.Fatalf("Expecting 2 requests but got %d", server.Requests())
+	}
+}
+
+func TestPagerBasePath(t *testing.T) {
+	server := newTestServer(t, testRoutes{"/awx/api/v2/hosts/": func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"count": 2, "next": null, "results": [{"id": 2}]}`)
+			return
+		}
+		// awx links include the path prefix of the server
+		fmt.Fprint(w, `{"count": 2, "next": "/awx/api/v2/hosts/?page=2", "results": [{"id": 1}]}`)
+	}})
+	client := &Client{Requester: newTestRequester(server.URL+"/awx", nil)}
+
+	hosts, err := ListAll[Host](context.Background(), client, hostsAPIEndpoint, nil)
+	if err != nil {
+		t.Fatalf("ListAll err: %s", err)
+	}
+	if len(hosts) != 2 || hosts[1].ID != 2 {
+		t.Fatalf("Expecting hosts 1 and 2 but got %d hosts", len(hosts))
+	}
+}
@@ -1,8 +1,11 @@
 package awx
 
+import "context"
+
 // PingService implements awx ping apis.
 type PingService interface {
 	Ping() (*Ping, error)
+	PingContext(ctx context.Context) (*Ping, error)
 }
 
 type pingServiceHTTP struct {
@@ -13,8 +16,13 @@ const pingAPIEndpoint = "/api/v2/ping/"
 
 // Ping do ping with awx servers.
 func (p *pingServiceHTTP) Ping() (*Ping, error) {
+	return p.PingContext(context.Background())
+}
+
+// PingContext is the context-aware version of Ping.
+func (p *pingServiceHTTP) PingContext(ctx context.Context) (*Ping, error) {
 	result := new(Ping)
-	resp, err := p.client.Requester.GetJSON(pingAPIEndpoint, result, map[string]string{})
+	resp, err := p.client.Requester.GetJSONContext(ctx, pingAPIEndpoint, result, map[string]string{})
 	if err != nil {
 		return nil, err
 	}
@@ -1,13 +1,16 @@
 package awx
 
 import (
+	"context"
 	"fmt"
 )
 
 // ProjectUpdatesService implements awx projects apis.
 type ProjectUpdateService interface {
 	ProjectUpdateCancel(id int) (*ProjectUpdateCancel, error)
+	ProjectUpdateCancelContext(ctx context.Context, id int) (*ProjectUpdateCancel, error)
 	ProjectUpdateGet(id int) (*Job, error)
+	ProjectUpdateGetContext(ctx context.Context, id int) (*Job, error)
 }
 
 type projectUpdateServiceHTTP struct {
@@ -19,9 +22,14 @@ const projectUpdatesAPIEndpoint = "/api/v2/project_updates/"
 
 // ProjectUpdateCancel cancel of awx projects update.
 func (p *projectUpdateServiceHTTP) ProjectUpdateCancel(id int) (*ProjectUpdateCancel, error) {
+	return p.ProjectUpdateCancelContext(context.Background(), id)
+}
+
+// ProjectUpdateCancelContext is the context-aware version of ProjectUpdateCancel.
+func (p *projectUpdateServiceHTTP) ProjectUpdateCancelContext(ctx context.Context, id int) (*ProjectUpdateCancel, error) {
 	result := new(ProjectUpdateCancel)
 	endpoint := fmt.Sprintf("%s%d/cancel", projectUpdatesAPIEndpoint, id)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, nil)
+	resp, err := p.client.Requester.GetJSONContext(ctx, endpoint, result, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -34,9 +42,14 @@ func (p *projectUpdateServiceHTTP) ProjectUpdateCancel(id int) (*ProjectUpdateCa
 
 // ProjectUpdateGet get of awx projects update.
 func (p *projectUpdateServiceHTTP) ProjectUpdateGet(id int) (*Job, error) {
+	return p.ProjectUpdateGetContext(context.Background(), id)
+}
+
+// ProjectUpdateGetContext is the context-aware version of ProjectUpdateGet.
+func (p *projectUpdateServiceHTTP) ProjectUpdateGetContext(ctx context.Context, id int) (*Job, error) {
 	result := new(Job)
 	endpoint := fmt.Sprintf("%s%d", projectUpdatesAPIEndpoint, id)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, nil)
+	resp, err := p.client.Requester.GetJSONContext(ctx, endpoint, result, nil)
 	if err != nil {
 		return nil, err
 	}
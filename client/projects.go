@@ -1,5 +1,7 @@
 package awx
 
+import "context"
+
 // ProjectService implements awx projects apis.
 type ProjectService interface {
 	List(params map[string]string) ([]*Project, *ResultsList[Project], error)
@@ -7,6 +9,34 @@ type ProjectService interface {
 	Create(data map[string]interface{}, params map[string]string) (*Project, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Project, error)
 	Delete(id int) (*Project, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Project, *ResultsList[Project], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Project, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Project, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Project, error)
+	DeleteContext(ctx context.Context, id int) (*Project, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Project, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Project]
+	ListQuery(ctx context.Context, q *Query) ([]*Project, *ResultsList[Project], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Project, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Project]
+	CreateWith(ctx context.Context, req CreateRequest[Project], params map[string]string) (*Project, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Project], params map[string]string) (*Project, error)
+	GetByName(name string, scope map[string]string) (*Project, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Project, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Project, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Project, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Project]) (*EnsureResult[Project], error)
+	Copy(id int, newName string) (*Project, error)
+	CopyContext(ctx context.Context, id int, newName string) (*Project, error)
+	CanCopy(id int) (*CopyCapability, error)
+	CanCopyContext(ctx context.Context, id int) (*CopyCapability, error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Project, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type projectServiceHTTP struct {
@@ -21,3 +51,66 @@ type ListProjectsResponse struct {
 }
 
 const projectsAPIEndpoint = "/api/v2/projects/"
+
+// ProjectCreateRequest holds the fields of a new project. The scm url is required for every scm
+// type but insights.
+type ProjectCreateRequest struct {
+	Name                  string `json:"name"`
+	Description           string `json:"description,omitempty"`
+	Organization          int    `json:"organization"`
+	ScmType               string `json:"scm_type,omitempty"`
+	ScmURL                string `json:"scm_url,omitempty"`
+	ScmBranch             string `json:"scm_branch,omitempty"`
+	ScmRefspec            string `json:"scm_refspec,omitempty"`
+	ScmClean              *bool  `json:"scm_clean,omitempty"`
+	ScmDeleteOnUpdate     *bool  `json:"scm_delete_on_update,omitempty"`
+	ScmUpdateOnLaunch     *bool  `json:"scm_update_on_launch,omitempty"`
+	ScmUpdateCacheTimeout int    `json:"scm_update_cache_timeout,omitempty"`
+	AllowOverride         *bool  `json:"allow_override,omitempty"`
+	Credential            int    `json:"credential,omitempty"`
+	LocalPath             string `json:"local_path,omitempty"`
+	Timeout               int    `json:"timeout,omitempty"`
+	DefaultEnvironment    int    `json:"default_environment,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *ProjectCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("organization", r.Organization != 0),
+		oneOf("scm_type", r.ScmType, "git", "svn", "insights", "archive"),
+		required("scm_url", r.ScmType == "" || r.ScmType == "insights" || r.ScmURL != ""),
+	)
+}
+
+func (*ProjectCreateRequest) createRequest(*Project) {}
+
+// ProjectUpdateRequest changes the scm settings or the update policy of a project.
+type ProjectUpdateRequest struct {
+	Name                  *string `json:"name,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	Organization          *int    `json:"organization,omitempty"`
+	ScmType               *string `json:"scm_type,omitempty"`
+	ScmURL                *string `json:"scm_url,omitempty"`
+	ScmBranch             *string `json:"scm_branch,omitempty"`
+	ScmRefspec            *string `json:"scm_refspec,omitempty"`
+	ScmClean              *bool   `json:"scm_clean,omitempty"`
+	ScmDeleteOnUpdate     *bool   `json:"scm_delete_on_update,omitempty"`
+	ScmUpdateOnLaunch     *bool   `json:"scm_update_on_launch,omitempty"`
+	ScmUpdateCacheTimeout *int    `json:"scm_update_cache_timeout,omitempty"`
+	AllowOverride         *bool   `json:"allow_override,omitempty"`
+	Credential            *int    `json:"credential,omitempty"`
+	LocalPath             *string `json:"local_path,omitempty"`
+	Timeout               *int    `json:"timeout,omitempty"`
+	DefaultEnvironment    *int    `json:"default_environment,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *ProjectUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		oneOf("scm_type", deref(r.ScmType), "git", "svn", "insights", "archive"),
+	)
+}
+
+func (*ProjectUpdateRequest) updateRequest(*Project) {}
@@ -0,0 +1,151 @@
+package awx
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Field lookups supported by the awx filters, see Query.Filter.
+const (
+	Exact       = "exact"
+	IExact      = "iexact"
+	Contains    = "contains"
+	IContains   = "icontains"
+	StartsWith  = "startswith"
+	IStartsWith = "istartswith"
+	EndsWith    = "endswith"
+	IEndsWith   = "iendswith"
+	Regex       = "regex"
+	IRegex      = "iregex"
+	GT          = "gt"
+	GTE         = "gte"
+	LT          = "lt"
+	LTE         = "lte"
+	In          = "in"
+	IsNull      = "isnull"
+)
+
+// Query builds the query string of awx list requests: filters, ordering, search and paging.
+// Unlike a map[string]string, a Query can repeat a key, e.g. to `or` several values of a field.
+//
+//	q := awx.NewQuery().
+//		Filter(awx.Field("inventory", "organization", "name"), awx.Exact, "Default").
+//		Or("name", awx.IStartsWith, "web").
+//		Or("name", awx.IStartsWith, "db").
+//		Not("enabled", "", "false").
+//		OrderBy("-modified", "name")
+type Query struct {
+	values url.Values
+}
+
+// NewQuery creates an empty Query.
+func NewQuery() *Query {
+	return &Query{values: url.Values{}}
+}
+
+// Field joins the fields of a related-field lookup, e.g. Field("inventory", "organization", "name")
+// is `inventory__organization__name`.
+func Field(fields ...string) string {
+	return strings.Join(fields, "__")
+}
+
+// Filter adds a `field__lookup=value` filter, the lookup may be empty for an exact match.
+func (q *Query) Filter(field, lookup, value string) *Query {
+	return q.Add(lookupKey(field, lookup), value)
+}
+
+// Eq adds a `field=value` filter.
+func (q *Query) Eq(field, value string) *Query {
+	return q.Filter(field, "", value)
+}
+
+// In adds a `field__in=value1,value2` filter.
+func (q *Query) In(field string, values ...string) *Query {
+	return q.Filter(field, In, strings.Join(values, ","))
+}
+
+// Not adds a negated `not__field__lookup=value` filter.
+func (q *Query) Not(field, lookup, value string) *Query {
+	return q.Add("not__"+lookupKey(field, lookup), value)
+}
+
+// Or adds a `or__field__lookup=value` filter, objects matching any of the `or` filters are returned.
+func (q *Query) Or(field, lookup, value string) *Query {
+	return q.Add("or__"+lookupKey(field, lookup), value)
+}
+
+// OrderBy sorts the results by fields, a field prefixed by `-` is sorted in descending order.
+func (q *Query) OrderBy(fields ...string) *Query {
+	return q.Set("order_by", strings.Join(fields, ","))
+}
+
+// Search adds a full text search on the searchable fields of the resource.
+func (q *Query) Search(term string) *Query {
+	return q.Add("search", term)
+}
+
+// PageSize sets the number of objects per page, up to MaxPageSize.
+func (q *Query) PageSize(size int) *Query {
+	return q.Set("page_size", strconv.Itoa(size))
+}
+
+// Page sets the page to request, starting at 1.
+func (q *Query) Page(page int) *Query {
+	return q.Set("page", strconv.Itoa(page))
+}
+
+// Add adds value to the values of key.
+func (q *Query) Add(key, value string) *Query {
+	if q.values == nil {
+		q.values = url.Values{}
+	}
+	q.values.Add(key, value)
+	return q
+}
+
+// Set replaces the values of key by value.
+func (q *Query) Set(key, value string) *Query {
+	if q.values == nil {
+		q.values = url.Values{}
+	}
+	q.values.Set(key, value)
+	return q
+}
+
+// Values returns a copy of the query parameters.
+func (q *Query) Values() url.Values {
+	if q == nil {
+		return url.Values{}
+	}
+	return cloneValues(q.values)
+}
+
+// Encode encodes the query in the url encoded form, sorted by key.
+func (q *Query) Encode() string {
+	return q.Values().Encode()
+}
+
+func lookupKey(field, lookup string) string {
+	if lookup == "" {
+		return field
+	}
+	return field + "__" + lookup
+}
+
+func cloneValues(values url.Values) url.Values {
+	cloned := make(url.Values, len(values))
+	for key, value := range values {
+		cloned[key] = append([]string(nil), value...)
+	}
+	return cloned
+}
+
+// mapValues converts the map form of the list parameters.
+func mapValues(params map[string]string) url.Values {
+	values := make(url.Values, len(params))
+	for key, value := range params {
+		values.Set(key, value)
+	}
+	return values
+}
@@ -0,0 +1,93 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestQueryEncode(t *testing.T) {
+	testTable := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name:  "related field",
+			query: NewQuery().Filter(Field("inventory", "organization", "name"), Exact, "Default"),
+			want:  "inventory__organization__name__exact=Default",
+		},
+		{
+			name:  "or repeats the key",
+			query: NewQuery().Or("name", IStartsWith, "web").Or("name", IStartsWith, "db"),
+			want:  "or__name__istartswith=web&or__name__istartswith=db",
+		},
+		{
+			name:  "not and in",
+			query: NewQuery().Not("enabled", "", "false").In("id", "1", "2"),
+			want:  "id__in=1%2C2&not__enabled=false",
+		},
+		{
+			name:  "order, search and paging",
+			query: NewQuery().OrderBy("-modified", "name").Search("deploy").PageSize(50),
+			want:  "order_by=-modified%2Cname&page_size=50&search=deploy",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.query.Encode(); got != test.want {
+				t.Fatalf("Expecting %s but got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestListQueryRepeatedKeys(t *testing.T) {
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		names := r.URL.Query()["or__name"]
+		fmt.Fprintf(w, `{"count": %d, "results": []}`, len(names))
+	}})
+
+	rs := NewAWXResourceService[Host](server.awxClient(), hostsAPIEndpoint, nil)
+	_, result, err := rs.ListQuery(context.Background(), NewQuery().Or("name", "", "web").Or("name", "", "db"))
+	if err != nil {
+		t.Fatalf("ListQuery err: %s", err)
+	}
+
+	if result.Count != 2 {
+		t.Fatalf("Expecting both or__name values to be sent but got %d", result.Count)
+	}
+}
+
+func TestSubListQuery(t *testing.T) {
+	var queries []string
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path+"?"+r.URL.RawQuery)
+		fmt.Fprint(w, `{"count": 1, "results": [{"id": 1}]}`)
+	}})
+	awx := newAWX(server.awxClient())
+	ctx := context.Background()
+	q := NewQuery().Or("event", "", "runner_on_failed").Or("event", "", "runner_on_unreachable")
+
+	if _, _, err := awx.JobService.GetJobEventsQuery(ctx, 7, q); err != nil {
+		t.Fatalf("GetJobEventsQuery err: %s", err)
+	}
+	if _, _, err := awx.TeamService.GetTeamUsersQuery(ctx, 4, q, &PaginationRequest{AllPages: Ptr(true)}); err != nil {
+		t.Fatalf("GetTeamUsersQuery err: %s", err)
+	}
+	if _, _, err := awx.TokenService.ListPersonalTokensQuery(ctx, 2, nil); err != nil {
+		t.Fatalf("ListPersonalTokensQuery err: %s", err)
+	}
+
+	want := []string{
+		"/api/v2/jobs/7/job_events/?or__event=runner_on_failed&or__event=runner_on_unreachable",
+		"/api/v2/teams/4/users/?or__event=runner_on_failed&or__event=runner_on_unreachable&page_size=200",
+		"/api/v2/users/2/personal_tokens/?",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Fatalf("Expecting the requests %v but got %v", want, queries)
+	}
+}
@@ -0,0 +1,120 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// redacted replaces the secrets in dumps and logs.
+const redacted = "REDACTED"
+
+// sensitiveHeaders are the headers carrying credentials.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	csrfHeaderName,
+}
+
+// sensitiveFields are the JSON fields whose values are redacted, at any depth.
+var sensitiveFields = map[string]bool{
+	// credential inputs hold passwords, keys and tokens
+	"inputs":        true,
+	"password":      true, // pragma: allowlist secret
+	"client_secret": true, // pragma: allowlist secret
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+// redactHeaders returns a copy of header with the credentials redacted.
+func redactHeaders(header http.Header) http.Header {
+	header = header.Clone()
+	for _, key := range sensitiveHeaders {
+		if _, ok := header[http.CanonicalHeaderKey(key)]; ok {
+			header.Set(key, redacted)
+		}
+	}
+	return header
+}
+
+// redactBody returns body with the sensitive JSON fields, or form fields, redacted.
+// Bodies which are neither are replaced by their size, as their secrets cannot be found.
+func redactBody(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err == nil {
+		if redactedBody, err := json.Marshal(redactValue(value)); err == nil {
+			return redactedBody
+		}
+	}
+
+	if form, ok := parseForm(body); ok {
+		for key, values := range form {
+			if sensitiveFields[key] {
+				for i := range values {
+					values[i] = redacted
+				}
+			}
+		}
+		return []byte(form.Encode())
+	}
+
+	return []byte(fmt.Sprintf("<%d bytes, neither JSON nor a form>", len(body)))
+}
+
+// parseForm parses body as an application/x-www-form-urlencoded form,
+// i.e. a single line of key=value pairs.
+func parseForm(body []byte) (url.Values, bool) {
+	if !bytes.Contains(body, []byte("=")) || bytes.ContainsAny(body, " \t\r\n") {
+		return nil, false
+	}
+	form, err := url.ParseQuery(string(body))
+	return form, err == nil
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[key] {
+				v[key] = maskValue(field)
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// maskValue redacts every leaf of value, the keys of objects are kept for debugging.
+func maskValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			v[key] = maskValue(field)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = maskValue(item)
+		}
+		return v
+	case nil:
+		return nil
+	}
+	return redacted
+}
@@ -0,0 +1,80 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// FollowRelated returns the object a related link points to, e.g. the project of a job template:
+//
+//	project, err := awx.FollowRelated[awx.Project](ctx, client, jobTemplate.Related.Project)
+//
+// The link is sent through the requester of client, it may be a path or an absolute URL of the awx server.
+// The error matches ErrNotFound when the link is empty.
+func FollowRelated[T any](ctx context.Context, client *Client, link string) (*T, error) {
+	endpoint, query, err := relatedEndpoint(client, link)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(T)
+	resp, err := client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListRelated returns every object of a related list link, e.g. the hosts of an inventory:
+//
+//	hosts, err := awx.ListRelated[awx.Host](ctx, client, inventory.Related.Hosts, nil)
+//
+// params filters the list, every page is fetched.
+// The error matches ErrNotFound when the link is empty.
+func ListRelated[T any](ctx context.Context, client *Client, link string, params map[string]string) ([]*T, error) {
+	endpoint, query, err := relatedEndpoint(client, link)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range params {
+		query.Add(key, value)
+	}
+	return listAll[T](ctx, client, endpoint, query)
+}
+
+// relatedEndpoint splits a related link into the endpoint and the query sent by the requester.
+// Absolute links are reduced to their path, relative to the requester base URL.
+func relatedEndpoint(client *Client, link string) (string, url.Values, error) {
+	if link == "" {
+		return "", nil, fmt.Errorf("%w: empty related link", ErrNotFound)
+	}
+
+	linkURL, err := url.Parse(link)
+	if err != nil {
+		return "", nil, err
+	}
+
+	base, err := url.Parse(client.Requester.Base)
+	if err != nil {
+		return "", nil, err
+	}
+	if linkURL.IsAbs() && linkURL.Host != base.Host {
+		return "", nil, fmt.Errorf("awx: related link %q is not on the awx server %s", link, base.Host)
+	}
+
+	// awx links include the path prefix of the server, which the requester adds again
+	endpoint := linkURL.Path
+	if prefix := strings.TrimSuffix(base.Path, "/"); prefix != "" {
+		endpoint = strings.TrimPrefix(endpoint, prefix)
+	}
+
+	return endpoint, linkURL.Query(), nil
+}
@@ -0,0 +1,79 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFollowRelated(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"/awx/api/v2/jobs/1/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"id": 1, "related": {"job_template": "/awx/api/v2/job_templates/5/"}}`)
+		},
+		"/awx/api/v2/job_templates/5/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"id": 5, "related": {"project": "/awx/api/v2/projects/7/"}}`)
+		},
+		"/awx/api/v2/projects/7/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"id": 7, "name": "playbooks"}`)
+		},
+	})
+
+	client := &Client{Requester: newTestRequester(server.URL+"/awx", nil)}
+	ctx := context.Background()
+
+	job, err := FollowRelated[Job](ctx, client, "/awx/api/v2/jobs/1/")
+	if err != nil {
+		t.Fatalf("FollowRelated job err: %s", err)
+	}
+	jobTemplate, err := FollowRelated[JobTemplate](ctx, client, job.Related.JobTemplate)
+	if err != nil {
+		t.Fatalf("FollowRelated job template err: %s", err)
+	}
+	// absolute links are accepted as long as they point to the same server
+	project, err := FollowRelated[Project](ctx, client, server.URL+jobTemplate.Related.Project)
+	if err != nil {
+		t.Fatalf("FollowRelated project err: %s", err)
+	}
+
+	if project.ID != 7 || project.Name != "playbooks" {
+		t.Fatalf("Expecting project 7 playbooks but got %d %s", project.ID, project.Name)
+	}
+}
+
+func TestFollowRelatedErrors(t *testing.T) {
+	client := &Client{Requester: newTestRequester("http://awx.example.com", nil)}
+
+	if _, err := FollowRelated[Project](context.Background(), client, ""); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expecting ErrNotFound for an empty link but got %v", err)
+	}
+	if _, err := FollowRelated[Project](context.Background(), client, "http://other.example.com/api/v2/projects/7/"); err == nil {
+		t.Fatalf("Expecting an error for a link to another server")
+	}
+}
+
+func TestListRelated(t *testing.T) {
+	server := newTestServer(t, testRoutes{"/api/v2/inventories/3/hosts/": func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("enabled") != "true" {
+			t.Errorf("Expecting the enabled filter but got %s", r.URL.RawQuery)
+		}
+
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"count": 2, "next": null, "results": [{"id": 2}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"count": 2, "next": "/api/v2/inventories/3/hosts/?enabled=true&page=2", "results": [{"id": 1}]}`)
+	}})
+
+	client := server.awxClient()
+	hosts, err := ListRelated[Host](context.Background(), client, "/api/v2/inventories/3/hosts/", map[string]string{"enabled": "true"})
+	if err != nil {
+		t.Fatalf("ListRelated err: %s", err)
+	}
+
+	if len(hosts) != 2 || hosts[0].ID != 1 || hosts[1].ID != 2 {
+		t.Fatalf("Expecting hosts 1 and 2 but got %d hosts", len(hosts))
+	}
+}
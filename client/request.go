@@ -1,11 +1,13 @@
 package awx
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -34,29 +36,6 @@ func NewAPIRequest(method string, endpoint string, payload io.Reader) *APIReques
 	return ar
 }
 
-type Authenticator interface {
-	addAuthenticationHeaders(*http.Request)
-}
-
-// BasicAuth represents http basic auth.
-type BasicAuth struct {
-	Username string
-	Password string
-}
-
-func (ba *BasicAuth) addAuthenticationHeaders(r *http.Request) {
-	r.SetBasicAuth(ba.Username, ba.Password)
-}
-
-// TokenAuth represents token authentication
-type TokenAuth struct {
-	Token string
-}
-
-func (ta *TokenAuth) addAuthenticationHeaders(r *http.Request) {
-	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ta.Token))
-}
-
 // Requester implemented a base http client.
 // It supports do POST/GET via an human-readable way,
 // in other word, all data is in `application/json` format.
@@ -67,10 +46,31 @@ type Requester struct {
 	Base          string
 	Authenticator Authenticator
 	Client        *http.Client
+	// RetryPolicy enables retries of transient failures, nil disables them.
+	RetryPolicy *RetryPolicy
+	// RateLimiter limits the rate of requests, nil disables it.
+	RateLimiter *RateLimiter
+	// ConcurrencyLimiter caps the number of requests in flight, nil disables it.
+	ConcurrencyLimiter *ConcurrencyLimiter
+	// UserAgent is sent as the User-Agent header when not empty.
+	UserAgent string
+	// Headers are added to every request.
+	Headers http.Header
+	// Logger receives the requester diagnostics, nil disables logging.
+	Logger *slog.Logger
+	// Middlewares wrap every http request, the first one is the outermost.
+	Middlewares []Middleware
+
+	stats requesterStats
 }
 
 // Do do the actual http request.
 func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...interface{}) (*http.Response, error) {
+	return r.DoContext(context.Background(), ar, responseStruct, options...)
+}
+
+// DoContext do the actual http request, the request is bound to ctx.
+func (r *Requester) DoContext(ctx context.Context, ar *APIRequest, responseStruct interface{}, options ...interface{}) (*http.Response, error) {
 	if !strings.HasSuffix(ar.Endpoint, "/") && ar.Method != "POST" {
 		ar.Endpoint += "/"
 	}
@@ -80,47 +80,45 @@ func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...in
 		return nil, err
 	}
 
+	// the query parameters are given as a map[string]string, url.Values or *Query,
+	// the last two may repeat a key
+	querystring := URL.Query()
 	for _, o := range options {
 		switch v := o.(type) {
 		case map[string]string:
-			querystring := make(url.Values)
 			for key, val := range v {
-				querystring.Set(key, val)
+				querystring.Add(key, val)
+			}
+		case url.Values:
+			for key, values := range v {
+				querystring[key] = append(querystring[key], values...)
+			}
+		case *Query:
+			for key, values := range v.Values() {
+				querystring[key] = append(querystring[key], values...)
 			}
-
-			URL.RawQuery = querystring.Encode()
 		}
 	}
-
-	var req *http.Request
-	req, err = http.NewRequest(ar.Method, URL.String(), ar.Payload)
-	if err != nil {
-		return nil, err
+	if len(querystring) > 0 {
+		URL.RawQuery = querystring.Encode()
 	}
 
-	r.Authenticator.addAuthenticationHeaders(req)
-
-	for k := range ar.Headers {
-		req.Header.Add(k, ar.Headers.Get(k))
+	// the payload is buffered so that it can be sent again on retries
+	var body []byte
+	if ar.Payload != nil {
+		body, err = ioutil.ReadAll(ar.Payload)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	response, err := r.Client.Do(req)
+	response, err := r.send(ctx, ar, URL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	if response.StatusCode == 400 { // Bad Request
-		var errorString string
-
-		errorList := map[string][]string{}
-		json.NewDecoder(response.Body).Decode(&errorList)
-
-		errorString = "Errors:"
-		for k, v := range errorList {
-			errorString = fmt.Sprintf("%s\n- %s: %+v", errorString, k, v)
-		}
-
-		return response, errors.New(errorString)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response, newAPIError(response)
 	}
 
 	switch responseStruct.(type) {
@@ -131,6 +129,97 @@ func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...in
 	}
 }
 
+// send performs the http request, retrying it according to the RetryPolicy.
+func (r *Requester) send(ctx context.Context, ar *APIRequest, URL string, body []byte) (*http.Response, error) {
+	session, _ := r.Authenticator.(sessionUpdater)
+	reauthenticator, _ := r.Authenticator.(Reauthenticator)
+	reauthenticated := false
+
+	for attempt := 1; ; attempt++ {
+		var payload io.Reader
+		if body != nil {
+			payload = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, ar.Method, URL, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.UserAgent != "" {
+			req.Header.Set("User-Agent", r.UserAgent)
+		}
+
+		for k, values := range r.Headers {
+			for _, v := range values {
+				req.Header.Add(k, v)
+			}
+		}
+
+		if r.Authenticator != nil {
+			if err := r.Authenticator.Authenticate(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+
+		for k := range ar.Headers {
+			req.Header.Add(k, ar.Headers.Get(k))
+		}
+
+		release, err := r.throttle(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := r.roundTrip(req)
+		if err != nil {
+			release()
+		} else {
+			response.Body = &releaseOnClose{ReadCloser: response.Body, release: release}
+		}
+
+		if session != nil && err == nil {
+			session.updateSession(response)
+		}
+
+		// the credentials expired, renew them once and replay the request
+		if reauthenticator != nil && err == nil && response.StatusCode == http.StatusUnauthorized && !reauthenticated {
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+
+			if err := reauthenticator.Reauthenticate(ctx); err != nil {
+				return nil, err
+			}
+			reauthenticated = true
+			attempt--
+			continue
+		}
+
+		if !r.RetryPolicy.shouldRetry(ctx, attempt, ar.Method, response, err) {
+			return response, err
+		}
+
+		wait := r.RetryPolicy.backoff(attempt, response)
+		if r.Logger != nil {
+			attrs := []any{"method", ar.Method, "url", URL, "attempt", attempt, "wait", wait}
+			if err != nil {
+				attrs = append(attrs, "error", err)
+			} else {
+				attrs = append(attrs, "status", response.StatusCode)
+			}
+			r.Logger.WarnContext(ctx, "retrying awx request", attrs...)
+		}
+		if response != nil {
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
 // ReadRawResponse reads the http raw response and store it into `responseStruct`.
 func (r *Requester) ReadRawResponse(response *http.Response, responseStruct interface{}) (*http.Response, error) {
 	defer response.Body.Close()
@@ -158,54 +247,123 @@ func (r *Requester) ReadJSONResponse(response *http.Response, responseStruct int
 
 // Get performs http get request.
 func (r *Requester) Get(endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+	return r.GetContext(context.Background(), endpoint, responseStruct, querystring)
+}
+
+// GetContext performs http get request bound to ctx.
+func (r *Requester) GetContext(ctx context.Context, endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("GET", endpoint, nil)
 	ar.Suffix = ""
-	return r.Do(ar, responseStruct, querystring)
+	return r.DoContext(ctx, ar, responseStruct, querystring)
 }
 
 // GetJSON performs http get request with json response.
 func (r *Requester) GetJSON(endpoint string, responseStruct interface{}, query map[string]string) (*http.Response, error) {
+	return r.GetJSONContext(context.Background(), endpoint, responseStruct, query)
+}
+
+// GetJSONContext performs http get request with json response bound to ctx.
+func (r *Requester) GetJSONContext(ctx context.Context, endpoint string, responseStruct interface{}, query map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("GET", endpoint, nil)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, query)
+	return r.DoContext(ctx, ar, &responseStruct, query)
+}
+
+// getJSONQueryContext performs http get request with query parameters which may repeat a key.
+func (r *Requester) getJSONQueryContext(ctx context.Context, endpoint string, responseStruct interface{}, query url.Values) (*http.Response, error) {
+	ar := NewAPIRequest("GET", endpoint, nil)
+	ar.SetHeader("Content-Type", "application/json")
+	return r.DoContext(ctx, ar, &responseStruct, query)
+}
+
+// openContext performs http get request and returns the response body unread, for large responses
+// which are streamed. The caller must close the body.
+func (r *Requester) openContext(ctx context.Context, endpoint string, query url.Values) (io.ReadCloser, error) {
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+
+	URL, err := url.Parse(r.Base + endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if len(query) > 0 {
+		URL.RawQuery = query.Encode()
+	}
+
+	response, err := r.send(ctx, NewAPIRequest("GET", endpoint, nil), URL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(response); err != nil {
+		return nil, err
+	}
+
+	return response.Body, nil
 }
 
 // Post performs http post request.
 func (r *Requester) Post(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+	return r.PostContext(context.Background(), endpoint, payload, responseStruct, querystring)
+}
+
+// PostContext performs http post request bound to ctx.
+func (r *Requester) PostContext(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("POST", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.DoContext(ctx, ar, &responseStruct, querystring)
 }
 
 // PutJSON perform http PUT request with json response
 func (r *Requester) PutJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+	return r.PutJSONContext(context.Background(), endpoint, payload, responseStruct, querystring)
+}
+
+// PutJSONContext perform http PUT request with json response bound to ctx.
+func (r *Requester) PutJSONContext(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("PUT", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.DoContext(ctx, ar, &responseStruct, querystring)
 }
 
 // PostJSON performs http post request with json response.
 func (r *Requester) PostJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+	return r.PostJSONContext(context.Background(), endpoint, payload, responseStruct, querystring)
+}
+
+// PostJSONContext performs http post request with json response bound to ctx.
+func (r *Requester) PostJSONContext(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("POST", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.DoContext(ctx, ar, &responseStruct, querystring)
 }
 
 // PatchJSON perform http patch request with json response
 func (r *Requester) PatchJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+	return r.PatchJSONContext(context.Background(), endpoint, payload, responseStruct, querystring)
+}
+
+// PatchJSONContext perform http patch request with json response bound to ctx.
+func (r *Requester) PatchJSONContext(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("PATCH", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.DoContext(ctx, ar, &responseStruct, querystring)
 }
 
 // Delete performs http Delete request.
 func (r *Requester) Delete(endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+	return r.DeleteContext(context.Background(), endpoint, responseStruct, querystring)
+}
+
+// DeleteContext performs http Delete request bound to ctx.
+func (r *Requester) DeleteContext(ctx context.Context, endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("DELETE", endpoint, nil)
 	ar.Suffix = ""
-	return r.Do(ar, responseStruct, querystring)
+	return r.DoContext(ctx, ar, responseStruct, querystring)
 }
@@ -0,0 +1,168 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyServer returns a server answering `status` to the first `failures` requests,
+// then `{"id": 1}`. Every received body is sent to bodies.
+func newFlakyServer(t *testing.T, failures int32, status int, bodies chan<- string) *testServer {
+	t.Helper()
+
+	var hits int32
+	return newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		hit := atomic.AddInt32(&hits, 1)
+		if bodies != nil {
+			body, _ := io.ReadAll(r.Body)
+			bodies <- string(body)
+		}
+
+		if hit <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{"id": 1}`))
+	}})
+}
+
+func TestRequesterRetry(t *testing.T) {
+	testTable := []struct {
+		name       string
+		method     string
+		failures   int32
+		status     int
+		policy     *RetryPolicy
+		wantHits   int32
+		wantStatus int
+	}{
+		{
+			name:     "no policy",
+			method:   http.MethodGet,
+			failures: 1,
+			status:   http.StatusServiceUnavailable,
+			wantHits: 1, wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "get recovers",
+			method:   http.MethodGet,
+			failures: 2,
+			status:   http.StatusBadGateway,
+			policy:   &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond},
+			wantHits: 3, wantStatus: http.StatusOK,
+		},
+		{
+			name:     "get gives up",
+			method:   http.MethodGet,
+			failures: 5,
+			status:   http.StatusGatewayTimeout,
+			policy:   &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond},
+			wantHits: 3, wantStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name:     "status not retryable",
+			method:   http.MethodGet,
+			failures: 1,
+			status:   http.StatusInternalServerError,
+			policy:   &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond},
+			wantHits: 1, wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:     "post not retried by default",
+			method:   http.MethodPost,
+			failures: 1,
+			status:   http.StatusServiceUnavailable,
+			policy:   &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond},
+			wantHits: 1, wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "post retried when allowed",
+			method:   http.MethodPost,
+			failures: 2,
+			status:   http.StatusServiceUnavailable,
+			policy:   &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, RetryNonIdempotent: true},
+			wantHits: 3, wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			bodies := make(chan string, 10)
+			server := newFlakyServer(t, tt.failures, tt.status, bodies)
+			r := newTestRequester(server.URL, tt.policy)
+
+			result := map[string]interface{}{}
+			var resp *http.Response
+			var err error
+			if tt.method == http.MethodPost {
+				resp, err = r.PostJSON("/api/v2/hosts/", strings.NewReader(`{"name": "host"}`), &result, nil)
+			} else {
+				resp, err = r.GetJSON("/api/v2/hosts/1/", &result, nil)
+			}
+
+			if got := int32(server.Requests()); got != tt.wantHits {
+				t.Errorf("Expecting %d requests but got %d", tt.wantHits, got)
+			}
+			if resp == nil || resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Expecting status %d but got %v (err: %v)", tt.wantStatus, resp, err)
+			}
+			if tt.wantStatus != http.StatusOK {
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) {
+					t.Errorf("Expecting an *APIError but got %v", err)
+				}
+			}
+
+			close(bodies)
+			if tt.method == http.MethodPost {
+				for body := range bodies {
+					if body != `{"name": "host"}` {
+						t.Errorf("Expecting the payload to be sent on every attempt but got %q", body)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRequesterRetryContextCanceled(t *testing.T) {
+	server := newFlakyServer(t, 10, http.StatusServiceUnavailable, nil)
+	r := newTestRequester(server.URL, &RetryPolicy{MaxAttempts: 10, MinBackoff: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := r.GetJSONContext(ctx, "/api/v2/hosts/1/", &map[string]interface{}{}, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expecting a deadline exceeded error but got %v", err)
+	}
+	if got := server.Requests(); got != 1 {
+		t.Errorf("Expecting 1 request but got %d", got)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, max := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second} {
+		if wait := policy.backoff(attempt, nil); wait < max/2 || wait > max {
+			t.Errorf("Expecting attempt %d backoff within [%s, %s] but got %s", attempt, max/2, max, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"1"}}}
+	if wait := policy.backoff(1, resp); wait != time.Second {
+		t.Errorf("Expecting Retry-After to be honored but got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if wait := policy.backoff(1, resp); wait != time.Second {
+		t.Errorf("Expecting Retry-After to be capped by MaxBackoff but got %s", wait)
+	}
+}
@@ -0,0 +1,148 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CreateRequest is implemented by the typed requests creating a resource of type T,
+// e.g. HostCreateRequest for Host.
+type CreateRequest[T any] interface {
+	// Validate checks the request before it is sent.
+	Validate() error
+	createRequest(*T)
+}
+
+// UpdateRequest is implemented by the typed requests partially updating a resource of type T,
+// e.g. HostUpdateRequest for Host. Only the fields set are sent.
+type UpdateRequest[T any] interface {
+	// Validate checks the request before it is sent.
+	Validate() error
+	updateRequest(*T)
+}
+
+// Ptr returns a pointer to v, to set the fields of the update requests.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// CreateWith validates and sends a typed create request.
+func (rs *AWXResourceService[T]) CreateWith(ctx context.Context, req CreateRequest[T], params map[string]string) (*T, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := new(T)
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rs.client.Requester.PostJSONContext(ctx, rs.basePath, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateWith validates and sends a typed update request, only the fields set are changed.
+func (rs *AWXResourceService[T]) UpdateWith(ctx context.Context, id int, req UpdateRequest[T], params map[string]string) (*T, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := new(T)
+	endpoint := fmt.Sprintf("%s%d", rs.basePath, id)
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rs.client.Requester.PatchJSONContext(ctx, endpoint, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// fieldCheck is the outcome of the validation of a request field.
+type fieldCheck struct {
+	field   string
+	missing bool
+	problem string
+}
+
+// required checks that a mandatory field is set.
+func required(field string, set bool) fieldCheck {
+	return fieldCheck{field: field, missing: !set}
+}
+
+// notEmpty checks that an optional string field is not emptied, e.g. the name of an update.
+func notEmpty(field string, value *string) fieldCheck {
+	if value != nil && *value == "" {
+		return fieldCheck{field: field, problem: "must not be empty"}
+	}
+	return fieldCheck{field: field}
+}
+
+// oneOf checks that a field is either empty or one of the allowed values.
+func oneOf(field, value string, allowed ...string) fieldCheck {
+	if value == "" {
+		return fieldCheck{field: field}
+	}
+	for _, a := range allowed {
+		if value == a {
+			return fieldCheck{field: field}
+		}
+	}
+	return fieldCheck{field: field, problem: fmt.Sprintf("must be one of %s, got %q", strings.Join(allowed, ", "), value)}
+}
+
+// between checks that an optional integer field is within [min, max].
+func between(field string, value *int, min, max int) fieldCheck {
+	if value != nil && (*value < min || *value > max) {
+		return fieldCheck{field: field, problem: fmt.Sprintf("must be between %d and %d, got %d", min, max, *value)}
+	}
+	return fieldCheck{field: field}
+}
+
+// checkFields returns an ErrValidation error describing the missing and invalid fields.
+func checkFields(checks ...fieldCheck) error {
+	var missing, invalid []string
+	for _, check := range checks {
+		switch {
+		case check.missing:
+			missing = append(missing, check.field)
+		case check.problem != "":
+			invalid = append(invalid, check.field+" "+check.problem)
+		}
+	}
+
+	if len(missing) > 0 {
+		return newMandatoryFieldsError(missing)
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("%w: %s", ErrValidation, strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// deref returns the value of p, the zero value when p is nil.
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,92 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRequestValidate(t *testing.T) {
+	testTable := []struct {
+		name    string
+		request interface{ Validate() error }
+		wantErr string
+	}{
+		{
+			name:    "valid create",
+			request: &HostCreateRequest{Name: "web-01", Inventory: 1},
+		},
+		{
+			name:    "missing fields",
+			request: &JobTemplateCreateRequest{Name: "deploy", Playbook: "site.yml"},
+			wantErr: "mandatory input arguments are absent: [project inventory]",
+		},
+		{
+			name:    "inventory prompted on launch",
+			request: &JobTemplateCreateRequest{Name: "deploy", Project: 1, Playbook: "site.yml", AskInventoryOnLaunch: Ptr(true)},
+		},
+		{
+			name:    "invalid enum",
+			request: &JobTemplateCreateRequest{Name: "deploy", Project: 1, Inventory: 1, Playbook: "site.yml", JobType: "runn"},
+			wantErr: `job_type must be one of run, check, got "runn"`,
+		},
+		{
+			name:    "emptied name",
+			request: &HostUpdateRequest{Name: Ptr("")},
+			wantErr: "name must not be empty",
+		},
+		{
+			name:    "out of range",
+			request: &JobTemplateUpdateRequest{Verbosity: Ptr(9)},
+			wantErr: "verbosity must be between 0 and 5, got 9",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.Validate()
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Expecting no error but got %s", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrValidation) || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("Expecting a validation error with %s but got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUpdateWithSendsOnlySetFields(t *testing.T) {
+	bodies := make(chan string, 1)
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- r.Method + " " + string(body)
+		w.Write([]byte(`{"id": 3, "enabled": false}`))
+	}})
+
+	rs := NewAWXResourceService[Host](server.awxClient(), hostsAPIEndpoint, nil)
+	host, err := rs.UpdateWith(context.Background(), 3, &HostUpdateRequest{Enabled: Ptr(false)}, nil)
+	if err != nil {
+		t.Fatalf("UpdateWith err: %s", err)
+	}
+
+	if got := <-bodies; got != `PATCH {"enabled":false}` {
+		t.Fatalf(`Expecting PATCH {"enabled":false} but got %s`, got)
+	}
+	if host.ID != 3 || host.Enabled {
+		t.Fatalf("Expecting the updated host but got %+v", host)
+	}
+
+	if _, err := rs.CreateWith(context.Background(), &HostCreateRequest{Name: "web-01"}, nil); !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expecting a validation error but got %v", err)
+	}
+	if len(bodies) != 0 {
+		t.Fatalf("Expecting an invalid request not to be sent")
+	}
+}
@@ -0,0 +1,162 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Defaults used by a RetryPolicy when the matching field is left empty.
+const (
+	DefaultRetryMaxAttempts = 4
+	DefaultRetryMinBackoff  = 500 * time.Millisecond
+	DefaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the status codes awx returns while its
+// web or task nodes are restarting or behind an overloaded load balancer.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures how the Requester retries transient failures.
+// A nil policy disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, doubled on each attempt.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including a `Retry-After` delay.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes overrides DefaultRetryableStatusCodes.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent enables retries of POST and PATCH requests.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent requests with the default settings.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		MinBackoff:  DefaultRetryMinBackoff,
+		MaxBackoff:  DefaultRetryMaxBackoff,
+	}
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil {
+		return 1
+	}
+	if p.MaxAttempts <= 0 {
+		return DefaultRetryMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether a request which got resp or err on its attempt-th try must be sent again.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, attempt int, method string, resp *http.Response, err error) bool {
+	if attempt >= p.maxAttempts() || ctx.Err() != nil {
+		return false
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	statusCodes := p.RetryableStatusCodes
+	if statusCodes == nil {
+		statusCodes = DefaultRetryableStatusCodes
+	}
+	for _, code := range statusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay to wait before the next attempt: the `Retry-After`
+// header when awx sent one, an exponential backoff with jitter otherwise.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = DefaultRetryMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > maxBackoff {
+				return maxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := minBackoff << uint(attempt-1)
+	if wait <= 0 || wait > maxBackoff {
+		wait = maxBackoff
+	}
+
+	// equal jitter: keep half of the delay, randomize the other half
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter decodes a `Retry-After` header, given either in seconds or as an http date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// sleepContext waits for d, returning early with the context error if ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -1,5 +1,7 @@
 package awx
 
+import "context"
+
 // SchedulesService implements awx projects apis.
 type ScheduleService interface {
 	List(params map[string]string) ([]*Schedule, *ResultsList[Schedule], error)
@@ -7,6 +9,30 @@ type ScheduleService interface {
 	Create(data map[string]interface{}, params map[string]string) (*Schedule, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Schedule, error)
 	Delete(id int) (*Schedule, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Schedule, *ResultsList[Schedule], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Schedule, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Schedule, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Schedule, error)
+	DeleteContext(ctx context.Context, id int) (*Schedule, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Schedule, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Schedule]
+	ListQuery(ctx context.Context, q *Query) ([]*Schedule, *ResultsList[Schedule], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Schedule, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Schedule]
+	CreateWith(ctx context.Context, req CreateRequest[Schedule], params map[string]string) (*Schedule, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Schedule], params map[string]string) (*Schedule, error)
+	GetByName(name string, scope map[string]string) (*Schedule, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Schedule, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Schedule, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Schedule, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Schedule]) (*EnsureResult[Schedule], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Schedule, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type scheduleServiceHTTP struct {
@@ -21,3 +47,48 @@ type ListSchedulesResponse struct {
 }
 
 const schedulesAPIEndpoint = "/api/v2/schedules/"
+
+// ScheduleCreateRequest holds the fields of a new schedule, running the unified job template
+// at the occurrences of its rrule.
+type ScheduleCreateRequest struct {
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description,omitempty"`
+	Rrule              string                 `json:"rrule"`
+	UnifiedJobTemplate int                    `json:"unified_job_template"`
+	Enabled            *bool                  `json:"enabled,omitempty"`
+	Inventory          int                    `json:"inventory,omitempty"`
+	ExtraData          map[string]interface{} `json:"extra_data,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *ScheduleCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("rrule", r.Rrule != ""),
+		required("unified_job_template", r.UnifiedJobTemplate != 0),
+	)
+}
+
+func (*ScheduleCreateRequest) createRequest(*Schedule) {}
+
+// ScheduleUpdateRequest changes the rrule, the launch values or the enabled flag of a schedule.
+type ScheduleUpdateRequest struct {
+	Name               *string                `json:"name,omitempty"`
+	Description        *string                `json:"description,omitempty"`
+	Rrule              *string                `json:"rrule,omitempty"`
+	UnifiedJobTemplate *int                   `json:"unified_job_template,omitempty"`
+	Enabled            *bool                  `json:"enabled,omitempty"`
+	Inventory          *int                   `json:"inventory,omitempty"`
+	ExtraData          map[string]interface{} `json:"extra_data,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *ScheduleUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		notEmpty("rrule", r.Rrule),
+		notEmpty("rrule", r.Rrule),
+	)
+}
+
+func (*ScheduleUpdateRequest) updateRequest(*Schedule) {}
@@ -0,0 +1,113 @@
+package awx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// testRoutes maps a route to its handler. A route is a path, optionally prefixed by a method as in
+// `DELETE /api/v2/hosts/1/`. A path ending with `*` matches every path starting with what precedes it.
+type testRoutes map[string]http.HandlerFunc
+
+// testServer is an httptest server dispatching the requests on a route table, the requests matching
+// no route get a 404. The handlers synchronize the state they share themselves.
+type testServer struct {
+	*httptest.Server
+
+	routes   testRoutes
+	prefixes []string
+	requests atomic.Int64
+}
+
+// newTestServer starts a testServer serving routes, it is closed at the end of the test.
+func newTestServer(t *testing.T, routes testRoutes) *testServer {
+	t.Helper()
+
+	return startTestServer(t, routes, httptest.NewServer)
+}
+
+// newTestTLSServer starts a testServer serving routes over TLS, it is closed at the end of the test.
+func newTestTLSServer(t *testing.T, routes testRoutes) *testServer {
+	t.Helper()
+
+	return startTestServer(t, routes, httptest.NewTLSServer)
+}
+
+func startTestServer(t *testing.T, routes testRoutes, start func(http.Handler) *httptest.Server) *testServer {
+	t.Helper()
+
+	s := &testServer{routes: routes}
+	for route := range routes {
+		if strings.HasSuffix(route, "*") {
+			s.prefixes = append(s.prefixes, route)
+		}
+	}
+	// the longest prefix wins
+	sort.Slice(s.prefixes, func(i, j int) bool { return len(s.prefixes[i]) > len(s.prefixes[j]) })
+
+	s.Server = start(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *testServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+
+	if handler := s.route(r.Method, r.URL.Path); handler != nil {
+		handler(w, r)
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+	w.Write([]byte(`{"detail": "Not found."}`))
+}
+
+// route returns the handler of the route matching method and path, nil when none does.
+func (s *testServer) route(method, path string) http.HandlerFunc {
+	for _, key := range []string{method + " " + path, path} {
+		if handler, ok := s.routes[key]; ok {
+			return handler
+		}
+	}
+
+	for _, prefix := range s.prefixes {
+		routeMethod, routePath, found := strings.Cut(strings.TrimSuffix(prefix, "*"), " ")
+		if !found {
+			routeMethod, routePath = "", routeMethod
+		}
+		if (routeMethod == "" || routeMethod == method) && strings.HasPrefix(path, routePath) {
+			return s.routes[prefix]
+		}
+	}
+	return nil
+}
+
+// Requests returns the number of requests received so far.
+func (s *testServer) Requests() int {
+	return int(s.requests.Load())
+}
+
+// awxClient returns a client sending its requests to the server, without retries.
+func (s *testServer) awxClient() *Client {
+	return &Client{Requester: newTestRequester(s.URL, nil)}
+}
+
+// writeJSON encodes v as the response body.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func newTestRequester(base string, policy *RetryPolicy) *Requester {
+	return &Requester{
+		Base:          base,
+		Authenticator: &TokenAuth{Token: "token"},
+		Client:        http.DefaultClient,
+		RetryPolicy:   policy,
+	}
+}
@@ -0,0 +1,223 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	loginEndpoint  = "/api/login/"
+	logoutEndpoint = "/api/logout/"
+
+	csrfCookieName = "csrftoken"
+	csrfHeaderName = "X-CSRFToken"
+)
+
+// sessionUpdater is implemented by authenticators relying on a server side session,
+// they see every response to keep their cookies up to date.
+type sessionUpdater interface {
+	updateSession(*http.Response)
+}
+
+// SessionAuth authenticates through the awx login page, for installs where basic auth is disabled.
+// The session and CSRF cookies are kept in a cookie jar and the session is
+// opened again whenever it expires.
+type SessionAuth struct {
+	BaseURL  string
+	Username string
+	Password string
+	// Client sends the login requests, its transport and timeout are reused, http.DefaultClient when nil.
+	Client *http.Client
+
+	mu       sync.Mutex
+	jar      http.CookieJar
+	loggedIn bool
+}
+
+// NewSessionAuth creates a SessionAuth logging in as username on the awx server at baseURL.
+func NewSessionAuth(baseURL, username, password string) *SessionAuth {
+	return &SessionAuth{
+		BaseURL:  baseURL,
+		Username: username,
+		Password: password, // pragma: allowlist secret
+	}
+}
+
+// Authenticate logs in if needed and adds the session cookies, and the CSRF token on unsafe methods.
+func (sa *SessionAuth) Authenticate(ctx context.Context, r *http.Request) error {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+
+	if !sa.loggedIn {
+		if err := sa.login(ctx); err != nil {
+			return err
+		}
+	}
+
+	var csrfToken string
+	for _, cookie := range sa.jar.Cookies(r.URL) {
+		r.AddCookie(cookie)
+		if cookie.Name == csrfCookieName {
+			csrfToken = cookie.Value
+		}
+	}
+
+	if !isSafeMethod(r.Method) && csrfToken != "" {
+		r.Header.Set(csrfHeaderName, csrfToken)
+		// django checks the referer of unsafe requests sent over https
+		r.Header.Set("Referer", sa.BaseURL+"/api/")
+	}
+
+	return nil
+}
+
+// LogValue implements slog.LogValuer, the password is redacted.
+func (sa *SessionAuth) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("base_url", sa.BaseURL),
+		slog.String("username", sa.Username),
+		slog.String("password", redacted),
+	)
+}
+
+func (sa *SessionAuth) updateSession(resp *http.Response) {
+	cookies := resp.Cookies()
+	if len(cookies) == 0 || resp.Request == nil {
+		return
+	}
+
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+
+	if sa.jar != nil {
+		sa.jar.SetCookies(resp.Request.URL, cookies)
+	}
+}
+
+// Reauthenticate opens a new session.
+func (sa *SessionAuth) Reauthenticate(ctx context.Context) error {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+
+	return sa.login(ctx)
+}
+
+// login opens a new session, the caller must hold the lock.
+func (sa *SessionAuth) login(ctx context.Context) error {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return err
+	}
+	sa.jar = jar
+	sa.loggedIn = false
+
+	client := sa.httpClient()
+	loginURL := sa.BaseURL + loginEndpoint
+
+	// the login page sets the CSRF cookie
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, loginURL, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := doAndDiscard(client, req); err != nil {
+		return err
+	}
+
+	u, err := url.Parse(loginURL)
+	if err != nil {
+		return err
+	}
+	var csrfToken string
+	for _, cookie := range sa.jar.Cookies(u) {
+		if cookie.Name == csrfCookieName {
+			csrfToken = cookie.Value
+		}
+	}
+
+	form := url.Values{
+		"username": {sa.Username},
+		"password": {sa.Password},
+		"next":     {"/api/"},
+	}
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, loginURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(csrfHeaderName, csrfToken)
+	req.Header.Set("Referer", loginURL)
+
+	statusCode, err := doAndDiscard(client, req)
+	if err != nil {
+		return err
+	}
+
+	// awx redirects to `next` on success and renders the login form again on failure
+	if statusCode != http.StatusFound && statusCode != http.StatusSeeOther {
+		return errors.New("awx: session login failed, check the username and password")
+	}
+
+	sa.loggedIn = true
+	return nil
+}
+
+// Close logs out, it implements io.Closer.
+func (sa *SessionAuth) Close() error {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+
+	if !sa.loggedIn {
+		return nil
+	}
+	sa.loggedIn = false
+
+	req, err := http.NewRequest(http.MethodGet, sa.BaseURL+logoutEndpoint, nil)
+	if err != nil {
+		return err
+	}
+	_, err = doAndDiscard(sa.httpClient(), req)
+	return err
+}
+
+// httpClient returns a client storing cookies in the session jar and not following redirects.
+func (sa *SessionAuth) httpClient() *http.Client {
+	client := &http.Client{
+		Jar: sa.jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	if sa.Client != nil {
+		client.Transport = sa.Client.Transport
+		client.Timeout = sa.Client.Timeout
+	}
+	return client
+}
+
+// doAndDiscard sends req without following redirects and returns the response status code.
+func doAndDiscard(client *http.Client, req *http.Request) (int, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp.StatusCode, newAPIError(resp)
+	}
+	io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, resp.Body.Close()
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newSessionServer fakes the awx login page and an api endpoint only accepting valid sessions.
+func newSessionServer(t *testing.T) (*testServer, func(), *int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	logins := 0
+	session := ""
+
+	server := newTestServer(t, testRoutes{
+		http.MethodGet + " " + loginEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			http.SetCookie(w, &http.Cookie{Name: csrfCookieName, Value: "csrf", Path: "/"})
+		},
+		http.MethodPost + " " + loginEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			r.ParseForm()
+			if r.Header.Get(csrfHeaderName) != "csrf" || r.PostForm.Get("password") != "secret" {
+				return
+			}
+			logins++
+			session = fmt.Sprintf("session-%d", logins)
+			http.SetCookie(w, &http.Cookie{Name: "awx_sessionid", Value: session, Path: "/"})
+			http.Redirect(w, r, "/api/", http.StatusFound)
+		},
+		"/*": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			cookie, err := r.Cookie("awx_sessionid")
+			if err != nil || cookie.Value != session {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.Method != http.MethodGet && r.Header.Get(csrfHeaderName) != "csrf" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(`{"id": 1}`))
+		},
+	})
+
+	expire := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		session = ""
+	}
+	return server, expire, &logins
+}
+
+func TestSessionAuth(t *testing.T) {
+	server, expire, logins := newSessionServer(t)
+
+	r := &Requester{
+		Base:          server.URL,
+		Authenticator: NewSessionAuth(server.URL, "admin", "secret"), // pragma: allowlist secret
+		Client:        http.DefaultClient,
+	}
+
+	if _, err := r.GetJSON("/api/v2/me/", &map[string]interface{}{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.PostJSON("/api/v2/hosts/", strings.NewReader(`{}`), &map[string]interface{}{}, nil); err != nil {
+		t.Fatalf("Expecting the CSRF token to be sent on POST but got %v", err)
+	}
+	if *logins != 1 {
+		t.Errorf("Expecting 1 login but got %d", *logins)
+	}
+
+	expire()
+	if _, err := r.GetJSON("/api/v2/me/", &map[string]interface{}{}, nil); err != nil {
+		t.Fatalf("Expecting a transparent login on 401 but got %v", err)
+	}
+	if *logins != 2 {
+		t.Errorf("Expecting 2 logins but got %d", *logins)
+	}
+}
+
+func TestSessionAuthBadCredentials(t *testing.T) {
+	server, _, _ := newSessionServer(t)
+
+	r := &Requester{
+		Base:          server.URL,
+		Authenticator: NewSessionAuth(server.URL, "admin", "wrong"), // pragma: allowlist secret
+		Client:        http.DefaultClient,
+	}
+
+	if _, err := r.GetJSON("/api/v2/me/", &map[string]interface{}{}, nil); err == nil {
+		t.Error("Expecting an error with bad credentials")
+	}
+}
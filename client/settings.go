@@ -2,16 +2,23 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // SettingService implements awx settings apis.
 type SettingService interface {
 	ListSettings(params map[string]string) ([]*SettingSummary, *ListSettingsResponse, error)
+	ListSettingsContext(ctx context.Context, params map[string]string) ([]*SettingSummary, *ListSettingsResponse, error)
+	ListSettingsQuery(ctx context.Context, q *Query) ([]*SettingSummary, *ListSettingsResponse, error)
 	GetSettingsBySlug(slug string, params map[string]string) (*Setting, error)
+	GetSettingsBySlugContext(ctx context.Context, slug string, params map[string]string) (*Setting, error)
 	UpdateSettings(slug string, data map[string]interface{}, params map[string]string) (*Setting, error)
+	UpdateSettingsContext(ctx context.Context, slug string, data map[string]interface{}, params map[string]string) (*Setting, error)
 	DeleteSettings(slug string) (*Setting, error)
+	DeleteSettingsContext(ctx context.Context, slug string) (*Setting, error)
 }
 
 type settingServiceHTTP struct {
@@ -28,8 +35,22 @@ const settingsAPIEndpoint = "/api/v2/settings/"
 
 // ListSettings shows list of awx settings.
 func (p *settingServiceHTTP) ListSettings(params map[string]string) ([]*SettingSummary, *ListSettingsResponse, error) {
+	return p.ListSettingsContext(context.Background(), params)
+}
+
+// ListSettingsContext is the context-aware version of ListSettings.
+func (p *settingServiceHTTP) ListSettingsContext(ctx context.Context, params map[string]string) ([]*SettingSummary, *ListSettingsResponse, error) {
+	return p.listSettings(ctx, mapValues(params))
+}
+
+// ListSettingsQuery lists the settings matching q.
+func (p *settingServiceHTTP) ListSettingsQuery(ctx context.Context, q *Query) ([]*SettingSummary, *ListSettingsResponse, error) {
+	return p.listSettings(ctx, q.Values())
+}
+
+func (p *settingServiceHTTP) listSettings(ctx context.Context, query url.Values) ([]*SettingSummary, *ListSettingsResponse, error) {
 	result := new(ListSettingsResponse)
-	resp, err := p.client.Requester.GetJSON(settingsAPIEndpoint, result, params)
+	resp, err := p.client.Requester.getJSONQueryContext(ctx, settingsAPIEndpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -43,9 +64,14 @@ func (p *settingServiceHTTP) ListSettings(params map[string]string) ([]*SettingS
 
 // GetSettingById shows the details of a setting.
 func (p *settingServiceHTTP) GetSettingsBySlug(slug string, params map[string]string) (*Setting, error) {
+	return p.GetSettingsBySlugContext(context.Background(), slug, params)
+}
+
+// GetSettingsBySlugContext is the context-aware version of GetSettingsBySlug.
+func (p *settingServiceHTTP) GetSettingsBySlugContext(ctx context.Context, slug string, params map[string]string) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s/", settingsAPIEndpoint, slug)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := p.client.Requester.GetJSONContext(ctx, endpoint, result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -59,13 +85,18 @@ func (p *settingServiceHTTP) GetSettingsBySlug(slug string, params map[string]st
 
 // UpdateSetting update an awx Setting.
 func (p *settingServiceHTTP) UpdateSettings(slug string, data map[string]interface{}, params map[string]string) (*Setting, error) {
+	return p.UpdateSettingsContext(context.Background(), slug, data, params)
+}
+
+// UpdateSettingsContext is the context-aware version of UpdateSettings.
+func (p *settingServiceHTTP) UpdateSettingsContext(ctx context.Context, slug string, data map[string]interface{}, params map[string]string) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s", settingsAPIEndpoint, slug)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := p.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := p.client.Requester.PatchJSONContext(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -79,10 +110,15 @@ func (p *settingServiceHTTP) UpdateSettings(slug string, data map[string]interfa
 
 // DeleteSetting delete an awx Setting.
 func (p *settingServiceHTTP) DeleteSettings(slug string) (*Setting, error) {
+	return p.DeleteSettingsContext(context.Background(), slug)
+}
+
+// DeleteSettingsContext is the context-aware version of DeleteSettings.
+func (p *settingServiceHTTP) DeleteSettingsContext(ctx context.Context, slug string) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s", settingsAPIEndpoint, slug)
 
-	resp, err := p.client.Requester.Delete(endpoint, result, nil)
+	resp, err := p.client.Requester.DeleteContext(ctx, endpoint, result, nil)
 	if err != nil {
 		return nil, err
 	}
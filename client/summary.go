@@ -0,0 +1,95 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+)
+
+// ObjectSummary holds the identifiers of an object. awx has no field selection: the whole object, with its
+// summary fields and related links, is still transferred, only the identifiers are decoded and kept in memory.
+type ObjectSummary struct {
+	ID   int    `json:"id"`
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	// Name is the field looked up by GetByName, the username of a user.
+	Name string `json:"name"`
+}
+
+// objectIdentifiers is decoded from a list, both names are kept until the service tells which one is used.
+type objectIdentifiers struct {
+	ID       int    `json:"id"`
+	Type     string `json:"type"`
+	URL      string `json:"url"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+// ListSummaries returns the identifiers of every object matching params, fetching all the pages.
+// The objects are transferred in full, only their identifiers are decoded.
+// Unless params sets `page_size`, pages of MaxPageSize objects are requested.
+func (rs *AWXResourceService[T]) ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error) {
+	identifiers, err := ListAll[objectIdentifiers](ctx, rs.client, rs.basePath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*ObjectSummary, len(identifiers))
+	for i, object := range identifiers {
+		summaries[i] = rs.summary(object)
+	}
+	return summaries, nil
+}
+
+// GetSummary returns the identifiers of the object with the given id.
+func (rs *AWXResourceService[T]) GetSummary(ctx context.Context, id int) (*ObjectSummary, error) {
+	object := new(objectIdentifiers)
+	endpoint := fmt.Sprintf("%s%d/", rs.basePath, id)
+	resp, err := rs.client.Requester.GetJSONContext(ctx, endpoint, object, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return rs.summary(object), nil
+}
+
+// summary keeps the name field of the service out of the decoded identifiers.
+func (rs *AWXResourceService[T]) summary(object *objectIdentifiers) *ObjectSummary {
+	summary := &ObjectSummary{ID: object.ID, Type: object.Type, URL: object.URL, Name: object.Name}
+	if rs.nameField == "username" {
+		summary.Name = object.Username
+	}
+	return summary
+}
+
+// ListIDs returns the id of every object matching params, fetching all the pages.
+func (rs *AWXResourceService[T]) ListIDs(ctx context.Context, params map[string]string) ([]int, error) {
+	summaries, err := rs.ListSummaries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(summaries))
+	for i, summary := range summaries {
+		ids[i] = summary.ID
+	}
+	return ids, nil
+}
+
+// ListNames returns the name of every object matching params, the username of the users,
+// fetching all the pages.
+func (rs *AWXResourceService[T]) ListNames(ctx context.Context, params map[string]string) ([]string, error) {
+	summaries, err := rs.ListSummaries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(summaries))
+	for i, summary := range summaries {
+		names[i] = summary.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,77 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListSummaries(t *testing.T) {
+	checkPageSize := func(r *http.Request) {
+		if got := r.URL.Query().Get("page_size"); got != "200" {
+			t.Errorf("Expecting page_size 200 but got %q", got)
+		}
+	}
+	server := newTestServer(t, testRoutes{
+		"/api/v2/users/": func(w http.ResponseWriter, r *http.Request) {
+			checkPageSize(r)
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 4, "type": "user", "username": "jdoe", "summary_fields": {}}]}`)
+		},
+		"/api/v2/users/4/": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"id": 4, "type": "user", "url": "/api/v2/users/4/", "username": "jdoe", "related": {}}`)
+		},
+		"/api/v2/hosts/": func(w http.ResponseWriter, r *http.Request) {
+			checkPageSize(r)
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `{"count": 2, "results": [{"id": 2, "name": "db-01", "related": {}}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"count": 2, "next": "/api/v2/hosts/?page=2&page_size=200", "results": [{"id": 1, "name": "web-01"}]}`)
+		},
+	})
+
+	client := server.awxClient()
+	hosts := NewAWXResourceService[Host](client, hostsAPIEndpoint, nil)
+	users := NewAWXResourceService[User](client, usersAPIEndpoint, nil).withNameField("username")
+	ctx := context.Background()
+
+	ids, err := hosts.ListIDs(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListIDs err: %s", err)
+	}
+	if !reflect.DeepEqual(ids, []int{1, 2}) {
+		t.Fatalf("Expecting ids [1 2] but got %v", ids)
+	}
+
+	names, err := hosts.ListNames(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListNames err: %s", err)
+	}
+	if !reflect.DeepEqual(names, []string{"web-01", "db-01"}) {
+		t.Fatalf("Expecting names [web-01 db-01] but got %v", names)
+	}
+
+	summaries, err := users.ListSummaries(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListSummaries err: %s", err)
+	}
+	want := []*ObjectSummary{{ID: 4, Type: "user", Name: "jdoe"}}
+	if !reflect.DeepEqual(summaries, want) {
+		t.Fatalf("Expecting %+v but got %+v", *want[0], *summaries[0])
+	}
+
+	summary, err := users.GetSummary(ctx, 4)
+	if err != nil {
+		t.Fatalf("GetSummary err: %s", err)
+	}
+	if got := (ObjectSummary{ID: 4, Type: "user", URL: "/api/v2/users/4/", Name: "jdoe"}); *summary != got {
+		t.Fatalf("Expecting %+v but got %+v", got, *summary)
+	}
+
+	if _, err := users.GetSummary(ctx, 5); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expecting ErrNotFound but got %v", err)
+	}
+}
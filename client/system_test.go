@@ -1,3 +1,5 @@
+//go:build integration
+
 package awx
 
 import (
@@ -17,7 +19,7 @@ var (
 	awxPassword string
 	awxToken    string
 
-	awxClient AWX
+	awxClient *AWX
 
 	credentialsServiceTestTable = []*TestRow{{
 		data: map[string]interface{}{
@@ -68,7 +70,7 @@ func TestCredentialsService(t *testing.T) {
 	for _, tt := range credentialsServiceTestTable {
 		t.Run("Create", func(t *testing.T) {
 			var err error
-			createResponse, err = awxClient.CreateCredentials(tt.data, tt.params)
+			createResponse, err = awxClient.CredentialService.Create(tt.data, tt.params)
 			if err != nil {
 				t.Error(err)
 			}
@@ -79,7 +81,7 @@ func TestCredentialsService(t *testing.T) {
 		})
 
 		t.Run("Fetch", func(t *testing.T) {
-			fetchResponse, err := awxClient.GetCredentialsByID(createResponse.ID, map[string]string{})
+			fetchResponse, err := awxClient.CredentialService.GetByID(createResponse.ID, map[string]string{})
 			if err != nil {
 				t.Error(err)
 			}
@@ -92,7 +94,7 @@ func TestCredentialsService(t *testing.T) {
 		t.Run("Update", func(t *testing.T) {
 			tt.data["name"] = "credential_x"
 
-			updateResponse, err := awxClient.UpdateCredentialsByID(createResponse.ID, tt.data,
+			updateResponse, err := awxClient.CredentialService.Update(createResponse.ID, tt.data,
 				map[string]string{})
 			if err != nil {
 				t.Error(err)
@@ -104,8 +106,7 @@ func TestCredentialsService(t *testing.T) {
 		})
 
 		t.Run("Delete", func(t *testing.T) {
-			err := awxClient.DeleteCredentialsByID(createResponse.ID,
-				map[string]string{})
+			_, err := awxClient.CredentialService.Delete(createResponse.ID)
 			if err != nil {
 				t.Error(err)
 			}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -14,14 +15,50 @@ type TeamService interface {
 	Create(data map[string]interface{}, params map[string]string) (*Team, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*Team, error)
 	Delete(id int) (*Team, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*Team, *ResultsList[Team], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*Team, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*Team, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Team, error)
+	DeleteContext(ctx context.Context, id int) (*Team, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*Team, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[Team]
+	ListQuery(ctx context.Context, q *Query) ([]*Team, *ResultsList[Team], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*Team, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[Team]
+	CreateWith(ctx context.Context, req CreateRequest[Team], params map[string]string) (*Team, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[Team], params map[string]string) (*Team, error)
+	GetByName(name string, scope map[string]string) (*Team, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*Team, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*Team, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*Team, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[Team]) (*EnsureResult[Team], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*Team, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 
 	ListTeamRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListTeamRolesResponse, error)
+	ListTeamRoleEntitlementsContext(ctx context.Context, id int, params map[string]string) ([]*ApplyRole, *ListTeamRolesResponse, error)
+	ListTeamRoleEntitlementsQuery(ctx context.Context, id int, q *Query) ([]*ApplyRole, *ListTeamRolesResponse, error)
 	GetTeamObjectRoles(id int, params map[string]string, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error)
+	GetTeamObjectRolesContext(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error)
+	GetTeamObjectRolesQuery(ctx context.Context, id int, q *Query, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error)
 	GetTeamUsers(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
+	GetTeamUsersContext(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
+	GetTeamUsersQuery(ctx context.Context, id int, q *Query, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
 	GetTeamAccessList(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
+	GetTeamAccessListContext(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
+	GetTeamAccessListQuery(ctx context.Context, id int, q *Query, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
 	AddTeamUser(id int, data map[string]interface{}) error
+	AddTeamUserContext(ctx context.Context, id int, data map[string]interface{}) error
 	RemoveTeamUser(id int, data map[string]interface{}) error
+	RemoveTeamUserContext(ctx context.Context, id int, data map[string]interface{}) error
+	UsersAssociation() *Association[Team, User]
 	UpdateTeamRoleEntitlement(id int, data map[string]interface{}, params map[string]string) (interface{}, error)
+	UpdateTeamRoleEntitlementContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (interface{}, error)
 }
 
 type teamServiceHTTP struct {
@@ -51,10 +88,57 @@ type ListTeamUsersResponse struct {
 
 const teamsAPIEndpoint = "/api/v2/teams/"
 
+// TeamCreateRequest holds the fields of a new team of the given organization.
+type TeamCreateRequest struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Organization int    `json:"organization"`
+}
+
+// Validate implements CreateRequest.
+func (r *TeamCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		required("organization", r.Organization != 0),
+	)
+}
+
+func (*TeamCreateRequest) createRequest(*Team) {}
+
+// TeamUpdateRequest renames a team or moves it to another organization.
+type TeamUpdateRequest struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Organization *int    `json:"organization,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *TeamUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+	)
+}
+
+func (*TeamUpdateRequest) updateRequest(*Team) {}
+
 func (t *teamServiceHTTP) ListTeamRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListTeamRolesResponse, error) {
+	return t.ListTeamRoleEntitlementsContext(context.Background(), id, params)
+}
+
+// ListTeamRoleEntitlementsContext is the context-aware version of ListTeamRoleEntitlements.
+func (t *teamServiceHTTP) ListTeamRoleEntitlementsContext(ctx context.Context, id int, params map[string]string) ([]*ApplyRole, *ListTeamRolesResponse, error) {
+	return t.listTeamRoleEntitlements(ctx, id, mapValues(params))
+}
+
+// ListTeamRoleEntitlementsQuery lists the roles of a team matching q.
+func (t *teamServiceHTTP) ListTeamRoleEntitlementsQuery(ctx context.Context, id int, q *Query) ([]*ApplyRole, *ListTeamRolesResponse, error) {
+	return t.listTeamRoleEntitlements(ctx, id, q.Values())
+}
+
+func (t *teamServiceHTTP) listTeamRoleEntitlements(ctx context.Context, id int, query url.Values) ([]*ApplyRole, *ListTeamRolesResponse, error) {
 	result := new(ListTeamRolesResponse)
 	endpoint := fmt.Sprintf("%s%d/roles/", teamsAPIEndpoint, id)
-	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := t.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -66,9 +150,31 @@ func (t *teamServiceHTTP) ListTeamRoleEntitlements(id int, params map[string]str
 }
 
 func (t *teamServiceHTTP) GetTeamObjectRoles(id int, params map[string]string, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
-	result := new(ListTeamRolesResponse)
+	return t.GetTeamObjectRolesContext(context.Background(), id, params, pagination)
+}
+
+// GetTeamObjectRolesContext is the context-aware version of GetTeamObjectRoles.
+func (t *teamServiceHTTP) GetTeamObjectRolesContext(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
+	return t.getTeamObjectRoles(ctx, id, mapValues(params), pagination)
+}
+
+// GetTeamObjectRolesQuery lists the object roles of a team matching q.
+func (t *teamServiceHTTP) GetTeamObjectRolesQuery(ctx context.Context, id int, q *Query, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
+	return t.getTeamObjectRoles(ctx, id, q.Values(), pagination)
+}
+
+func (t *teamServiceHTTP) getTeamObjectRoles(ctx context.Context, id int, query url.Values, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/object_roles/", teamsAPIEndpoint, id)
-	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
+	if pagination.allPages() {
+		roles, err := listAll[ApplyRole](ctx, t.client, endpoint, query)
+		if err != nil {
+			return nil, nil, err
+		}
+		return roles, nil, nil
+	}
+
+	result := new(ListTeamRolesResponse)
+	resp, err := t.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -80,16 +186,30 @@ func (t *teamServiceHTTP) GetTeamObjectRoles(id int, params map[string]string, p
 }
 
 func (t *teamServiceHTTP) GetTeamUsers(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+	return t.GetTeamUsersContext(context.Background(), id, params, pagination)
+}
+
+// GetTeamUsersContext is the context-aware version of GetTeamUsers.
+func (t *teamServiceHTTP) GetTeamUsersContext(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+	return t.getTeamUsers(ctx, id, mapValues(params), pagination)
+}
+
+// GetTeamUsersQuery lists the users of a team matching q.
+func (t *teamServiceHTTP) GetTeamUsersQuery(ctx context.Context, id int, q *Query, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+	return t.getTeamUsers(ctx, id, q.Values(), pagination)
+}
+
+func (t *teamServiceHTTP) getTeamUsers(ctx context.Context, id int, query url.Values, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/users/", teamsAPIEndpoint, id)
-	if *pagination.AllPages {
-		users, err := t.getAllTeamUsersPages(endpoint, params)
+	if pagination.allPages() {
+		users, err := listAll[User](ctx, t.client, endpoint, query)
 		if err != nil {
 			return nil, nil, err
 		}
 		return users, nil, nil
 	} else {
 		result := new(ListTeamUsersResponse)
-		resp, err := t.client.Requester.GetJSON(endpoint, result, params)
+		resp, err := t.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 		if err != nil {
 			return nil, result, err
 		}
@@ -102,16 +222,30 @@ func (t *teamServiceHTTP) GetTeamUsers(id int, params map[string]string, paginat
 }
 
 func (t *teamServiceHTTP) GetTeamAccessList(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+	return t.GetTeamAccessListContext(context.Background(), id, params, pagination)
+}
+
+// GetTeamAccessListContext is the context-aware version of GetTeamAccessList.
+func (t *teamServiceHTTP) GetTeamAccessListContext(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+	return t.getTeamAccessList(ctx, id, mapValues(params), pagination)
+}
+
+// GetTeamAccessListQuery lists the users having access to a team matching q.
+func (t *teamServiceHTTP) GetTeamAccessListQuery(ctx context.Context, id int, q *Query, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+	return t.getTeamAccessList(ctx, id, q.Values(), pagination)
+}
+
+func (t *teamServiceHTTP) getTeamAccessList(ctx context.Context, id int, query url.Values, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/access_list/", teamsAPIEndpoint, id)
-	if *pagination.AllPages {
-		users, err := t.getAllTeamUsersPages(endpoint, params)
+	if pagination.allPages() {
+		users, err := listAll[User](ctx, t.client, endpoint, query)
 		if err != nil {
 			return nil, nil, err
 		}
 		return users, nil, nil
 	} else {
 		result := new(ListTeamUsersResponse)
-		resp, err := t.client.Requester.GetJSON(endpoint, result, params)
+		resp, err := t.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 		if err != nil {
 			return nil, result, err
 		}
@@ -125,59 +259,31 @@ func (t *teamServiceHTTP) GetTeamAccessList(id int, params map[string]string, pa
 
 // AddTeamUser will add the user as member in destination team
 func (t *teamServiceHTTP) AddTeamUser(id int, data map[string]interface{}) error {
-	endpoint := fmt.Sprintf("%s%d/users/", teamsAPIEndpoint, id)
-	data["associate"] = true
-	mandatoryFields := []string{"id", "associate"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return err
-	}
-
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-	resp, err := t.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, nil)
-	if err != nil {
-		return err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return err
-	}
+	return t.AddTeamUserContext(context.Background(), id, data)
+}
 
-	return nil
+// AddTeamUserContext is the context-aware version of AddTeamUser.
+func (t *teamServiceHTTP) AddTeamUserContext(ctx context.Context, id int, data map[string]interface{}) error {
+	data["associate"] = true
+	return t.UsersAssociation().send(ctx, id, data, nil)
 }
 
 // RemoveTeamUser will remove the user from destination team without deleting the user
 func (t *teamServiceHTTP) RemoveTeamUser(id int, data map[string]interface{}) error {
-	endpoint := fmt.Sprintf("%s%d/users/", teamsAPIEndpoint, id)
-	data["disassociate"] = true
-	mandatoryFields := []string{"id", "disassociate"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return err
-	}
-
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-	resp, err := t.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, nil)
-	if err != nil {
-		return err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return err
-	}
+	return t.RemoveTeamUserContext(context.Background(), id, data)
+}
 
-	return nil
+// RemoveTeamUserContext is the context-aware version of RemoveTeamUser.
+func (t *teamServiceHTTP) RemoveTeamUserContext(ctx context.Context, id int, data map[string]interface{}) error {
+	data["disassociate"] = true
+	return t.UsersAssociation().send(ctx, id, data, nil)
 }
 
 func (t *teamServiceHTTP) UpdateTeamRoleEntitlement(id int, data map[string]interface{}, params map[string]string) (interface{}, error) {
+	return t.UpdateTeamRoleEntitlementContext(context.Background(), id, data, params)
+}
+
+func (t *teamServiceHTTP) UpdateTeamRoleEntitlementContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (interface{}, error) {
 	result := new(interface{})
 	endpoint := fmt.Sprintf("%s%d/roles/", teamsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -185,7 +291,7 @@ func (t *teamServiceHTTP) UpdateTeamRoleEntitlement(id int, data map[string]inte
 		return nil, err
 	}
 
-	resp, err := t.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := t.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -197,44 +303,7 @@ func (t *teamServiceHTTP) UpdateTeamRoleEntitlement(id int, data map[string]inte
 	return result, nil
 }
 
-// Must be replaced by a generic function
-// But upgrade to version go 1.18 before
-func (t *teamServiceHTTP) getAllTeamUsersPages(firstURL string, params map[string]string) ([]*User, error) {
-	results := make([]*User, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListTeamUsersResponse)
-		resp, err := t.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
+// UsersAssociation returns the Association of the users of the teams.
+func (t *teamServiceHTTP) UsersAssociation() *Association[Team, User] {
+	return NewAssociation[Team, User](t.client, "users")
 }
@@ -0,0 +1,182 @@
+package awx
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting the rate of requests sent to awx.
+// It is safe for concurrent use and can be shared between several requesters.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing requestsPerSecond requests per second
+// on average, with bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed to be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, wait); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token, possibly going in debt, and returns how long to wait for it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a request which did not wait for it.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+}
+
+// ConcurrencyLimiter caps the number of requests in flight.
+// It is safe for concurrent use and can be shared between several requesters.
+type ConcurrencyLimiter struct {
+	slots chan struct{}
+}
+
+// NewConcurrencyLimiter creates a ConcurrencyLimiter allowing maxInFlight requests at the same time.
+func NewConcurrencyLimiter(maxInFlight int) *ConcurrencyLimiter {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+	return &ConcurrencyLimiter{slots: make(chan struct{}, maxInFlight)}
+}
+
+// Acquire blocks until a slot is available or ctx is done.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire.
+func (l *ConcurrencyLimiter) Release() {
+	<-l.slots
+}
+
+// RequesterStats reports the throttling activity of a Requester.
+type RequesterStats struct {
+	// Requests is the number of http requests sent, retries included.
+	Requests int64
+	// InFlight is the number of requests currently holding a concurrency slot.
+	InFlight int64
+	// RateLimitWait is the total time spent waiting for the RateLimiter.
+	RateLimitWait time.Duration
+	// ConcurrencyWait is the total time spent waiting for the ConcurrencyLimiter.
+	ConcurrencyWait time.Duration
+}
+
+type requesterStats struct {
+	requests        atomic.Int64
+	inFlight        atomic.Int64
+	rateLimitWait   atomic.Int64
+	concurrencyWait atomic.Int64
+}
+
+// Stats returns a snapshot of the throttling activity of the requester.
+func (r *Requester) Stats() RequesterStats {
+	return RequesterStats{
+		Requests:        r.stats.requests.Load(),
+		InFlight:        r.stats.inFlight.Load(),
+		RateLimitWait:   time.Duration(r.stats.rateLimitWait.Load()),
+		ConcurrencyWait: time.Duration(r.stats.concurrencyWait.Load()),
+	}
+}
+
+// throttle waits for the rate and concurrency limiters, it returns the function
+// releasing the concurrency slot once the request is done.
+func (r *Requester) throttle(ctx context.Context) (func(), error) {
+	if r.RateLimiter != nil {
+		start := time.Now()
+		err := r.RateLimiter.Wait(ctx)
+		r.stats.rateLimitWait.Add(int64(time.Since(start)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r.stats.requests.Add(1)
+	if r.ConcurrencyLimiter == nil {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+	err := r.ConcurrencyLimiter.Acquire(ctx)
+	r.stats.concurrencyWait.Add(int64(time.Since(start)))
+	if err != nil {
+		return nil, err
+	}
+	r.stats.inFlight.Add(1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.stats.inFlight.Add(-1)
+			r.ConcurrencyLimiter.Release()
+		})
+	}, nil
+}
+
+// releaseOnClose releases a concurrency slot once the response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,77 @@
+package awx
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequesterConcurrencyLimiter(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := newTestServer(t, testRoutes{"/*": func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(`{"id": 1}`))
+	}})
+
+	r := newTestRequester(server.URL, nil)
+	r.ConcurrencyLimiter = NewConcurrencyLimiter(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.GetJSON("/api/v2/hosts/1/", &map[string]interface{}{}, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("Expecting at most 2 requests in flight but got %d", maxInFlight)
+	}
+
+	stats := r.Stats()
+	if stats.Requests != 10 || stats.InFlight != 0 {
+		t.Errorf("Expecting 10 requests and none in flight but got %+v", stats)
+	}
+	if stats.ConcurrencyWait == 0 {
+		t.Errorf("Expecting time spent waiting for a slot but got %+v", stats)
+	}
+}
+
+func TestRequesterRateLimiter(t *testing.T) {
+	server := newFlakyServer(t, 0, http.StatusOK, nil)
+
+	r := newTestRequester(server.URL, nil)
+	r.RateLimiter = NewRateLimiter(100, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := r.GetJSON("/api/v2/hosts/1/", &map[string]interface{}{}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("Expecting 5 requests at 100 req/s to last at least 40ms but got %s", elapsed)
+	}
+	if got := server.Requests(); got != 5 {
+		t.Errorf("Expecting 5 requests but got %d", got)
+	}
+	if r.Stats().RateLimitWait == 0 {
+		t.Errorf("Expecting time spent waiting for the rate limiter but got %+v", r.Stats())
+	}
+}
@@ -0,0 +1,119 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// TokenService implements awx OAuth2 tokens apis.
+// Deleting a token revokes it. Tokens have neither a name nor a natural key,
+// they are only listed, fetched by id, created and revoked.
+type TokenService interface {
+	List(params map[string]string) ([]*OAuth2Token, *ResultsList[OAuth2Token], error)
+	GetByID(id int, params map[string]string) (*OAuth2Token, error)
+	Create(data map[string]interface{}, params map[string]string) (*OAuth2Token, error)
+	Delete(id int) (*OAuth2Token, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*OAuth2Token, *ResultsList[OAuth2Token], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*OAuth2Token, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*OAuth2Token, error)
+	DeleteContext(ctx context.Context, id int) (*OAuth2Token, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*OAuth2Token, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[OAuth2Token]
+	ListQuery(ctx context.Context, q *Query) ([]*OAuth2Token, *ResultsList[OAuth2Token], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*OAuth2Token, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[OAuth2Token]
+	CreateWith(ctx context.Context, req CreateRequest[OAuth2Token], params map[string]string) (*OAuth2Token, error)
+
+	ListPersonalTokens(userID int, params map[string]string) ([]*OAuth2Token, *ResultsList[OAuth2Token], error)
+	ListPersonalTokensContext(ctx context.Context, userID int, params map[string]string) ([]*OAuth2Token, *ResultsList[OAuth2Token], error)
+	ListPersonalTokensQuery(ctx context.Context, userID int, q *Query) ([]*OAuth2Token, *ResultsList[OAuth2Token], error)
+	CreatePersonalToken(userID int, data map[string]interface{}, params map[string]string) (*OAuth2Token, error)
+	CreatePersonalTokenContext(ctx context.Context, userID int, data map[string]interface{}, params map[string]string) (*OAuth2Token, error)
+}
+
+type tokenServiceHTTP struct {
+	AWXResourceService[OAuth2Token]
+	client *Client
+}
+
+const (
+	tokensAPIEndpoint         = "/api/v2/tokens/"
+	personalTokensAPIEndpoint = "/api/v2/users/%d/personal_tokens/"
+)
+
+// OAuth2TokenCreateRequest holds the fields of a new OAuth2 token, a personal access token
+// when it has no application.
+type OAuth2TokenCreateRequest struct {
+	Description string `json:"description,omitempty"`
+	Application int    `json:"application,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *OAuth2TokenCreateRequest) Validate() error {
+	return checkFields(
+		oneOf("scope", r.Scope, "read", "write"),
+	)
+}
+
+func (*OAuth2TokenCreateRequest) createRequest(*OAuth2Token) {}
+
+// ListPersonalTokens shows the personal tokens of a user.
+func (t *tokenServiceHTTP) ListPersonalTokens(userID int, params map[string]string) ([]*OAuth2Token, *ResultsList[OAuth2Token], error) {
+	return t.ListPersonalTokensContext(context.Background(), userID, params)
+}
+
+// ListPersonalTokensContext is the context-aware version of ListPersonalTokens.
+func (t *tokenServiceHTTP) ListPersonalTokensContext(ctx context.Context, userID int, params map[string]string) ([]*OAuth2Token, *ResultsList[OAuth2Token], error) {
+	return t.listPersonalTokens(ctx, userID, mapValues(params))
+}
+
+// ListPersonalTokensQuery lists the personal tokens of a user matching q.
+func (t *tokenServiceHTTP) ListPersonalTokensQuery(ctx context.Context, userID int, q *Query) ([]*OAuth2Token, *ResultsList[OAuth2Token], error) {
+	return t.listPersonalTokens(ctx, userID, q.Values())
+}
+
+func (t *tokenServiceHTTP) listPersonalTokens(ctx context.Context, userID int, query url.Values) ([]*OAuth2Token, *ResultsList[OAuth2Token], error) {
+	result := new(ResultsList[OAuth2Token])
+	endpoint := fmt.Sprintf(personalTokensAPIEndpoint, userID)
+	resp, err := t.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// CreatePersonalToken creates a personal token, not bound to any application, for a user.
+// The token value is only returned by this call.
+func (t *tokenServiceHTTP) CreatePersonalToken(userID int, data map[string]interface{}, params map[string]string) (*OAuth2Token, error) {
+	return t.CreatePersonalTokenContext(context.Background(), userID, data, params)
+}
+
+// CreatePersonalTokenContext is the context-aware version of CreatePersonalToken.
+func (t *tokenServiceHTTP) CreatePersonalTokenContext(ctx context.Context, userID int, data map[string]interface{}, params map[string]string) (*OAuth2Token, error) {
+	result := new(OAuth2Token)
+	endpoint := fmt.Sprintf(personalTokensAPIEndpoint, userID)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,145 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// newTokensServer fakes the tokens of the user 4, the token 1 is an application token.
+func newTokensServer(t *testing.T) (*testServer, *[]string) {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		nextID  = 3
+		revoked []string
+		tokens  = map[int]map[string]interface{}{
+			1: {"id": 1, "user": 4, "application": 2, "scope": "read"},
+			2: {"id": 2, "user": 4, "scope": "write"},
+		}
+	)
+	list := func(w http.ResponseWriter, personal bool) {
+		results := []map[string]interface{}{}
+		for id := 1; id < nextID; id++ {
+			if token, ok := tokens[id]; ok && (!personal || token["application"] == nil) {
+				results = append(results, token)
+			}
+		}
+		writeJSON(w, map[string]interface{}{"count": len(results), "results": results})
+	}
+	create := func(w http.ResponseWriter, r *http.Request) {
+		token := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&token)
+		token["id"] = nextID
+		token["user"] = 4
+		token["token"] = fmt.Sprintf("token-%d", nextID)
+		tokens[nextID] = token
+		nextID++
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, token)
+	}
+
+	server := newTestServer(t, testRoutes{
+		"GET " + tokensAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			list(w, false)
+		},
+		"POST " + tokensAPIEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			create(w, r)
+		},
+		"GET /api/v2/users/4/personal_tokens/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			list(w, true)
+		},
+		"POST /api/v2/users/4/personal_tokens/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			create(w, r)
+		},
+		"DELETE " + tokensAPIEndpoint + "*": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			var id int
+			fmt.Sscanf(r.URL.Path, tokensAPIEndpoint+"%d/", &id)
+			if _, ok := tokens[id]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(tokens, id)
+			revoked = append(revoked, fmt.Sprint(id))
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+
+	return server, &revoked
+}
+
+func TestTokenService(t *testing.T) {
+	server, revoked := newTokensServer(t)
+	awx := newAWX(server.awxClient())
+	ctx := context.Background()
+
+	created, err := awx.TokenService.CreateWith(ctx, &OAuth2TokenCreateRequest{Application: 2, Scope: "read"}, nil)
+	if err != nil {
+		t.Fatalf("CreateWith err: %s", err)
+	}
+	if created.ID != 3 || created.Token != "token-3" || created.Application != 2 {
+		t.Fatalf("Expecting the application token 3 but got %+v", created)
+	}
+	if _, err := awx.TokenService.CreateWith(ctx, &OAuth2TokenCreateRequest{Scope: "admin"}, nil); !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expecting a validation error on the admin scope but got %v", err)
+	}
+
+	personal, err := awx.TokenService.CreatePersonalToken(4, map[string]interface{}{"description": "ci token", "scope": "write"}, nil)
+	if err != nil {
+		t.Fatalf("CreatePersonalToken err: %s", err)
+	}
+	if personal.ID != 4 || personal.Token != "token-4" || personal.Description != "ci token" {
+		t.Fatalf("Expecting the personal token 4 but got %+v", personal)
+	}
+
+	tokens, _, err := awx.TokenService.List(nil)
+	if err != nil {
+		t.Fatalf("List err: %s", err)
+	}
+	if ids := tokenIDs(tokens); !reflect.DeepEqual(ids, []int{1, 2, 3, 4}) {
+		t.Fatalf("Expecting the tokens [1 2 3 4] but got %v", ids)
+	}
+
+	personals, _, err := awx.TokenService.ListPersonalTokens(4, nil)
+	if err != nil {
+		t.Fatalf("ListPersonalTokens err: %s", err)
+	}
+	if ids := tokenIDs(personals); !reflect.DeepEqual(ids, []int{2, 4}) {
+		t.Fatalf("Expecting the personal tokens [2 4] but got %v", ids)
+	}
+
+	if _, err := awx.TokenService.Delete(2); err != nil {
+		t.Fatalf("Delete err: %s", err)
+	}
+	if _, err := awx.TokenService.Delete(2); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expecting the revoked token not to be found but got %v", err)
+	}
+	if !reflect.DeepEqual(*revoked, []string{"2"}) {
+		t.Fatalf("Expecting the token 2 to be revoked but got %v", *revoked)
+	}
+}
+
+func tokenIDs(tokens []*OAuth2Token) []int {
+	ids := []int{}
+	for _, token := range tokens {
+		ids = append(ids, token.ID)
+	}
+	return ids
+}
@@ -36,6 +36,24 @@ type Application struct {
 	OrganizationID         int      `json:"organization"`
 }
 
+// OAuth2Token represents an awx personal or application token.
+type OAuth2Token struct {
+	ID            int       `json:"id"`
+	Type          string    `json:"type"`
+	URL           string    `json:"url"`
+	Related       *Related  `json:"related"`
+	SummaryFields *Summary  `json:"summary_fields"`
+	Created       time.Time `json:"created"`
+	Modified      time.Time `json:"modified"`
+	Description   string    `json:"description"`
+	User          int       `json:"user"`
+	Token         string    `json:"token"`
+	RefreshToken  string    `json:"refresh_token"`
+	Application   int       `json:"application"`
+	Expires       time.Time `json:"expires"`
+	Scope         string    `json:"scope"`
+}
+
 // ProjectUpdateCancel represents the awx project update cancel api response.
 type ProjectUpdateCancel struct {
 	CanCancel bool `json:"can_cancel"`
@@ -369,6 +387,7 @@ type JobTemplate struct {
 	Modified              time.Time   `json:"modified"`
 	Name                  string      `json:"name"`
 	Description           string      `json:"description"`
+	Organization          int         `json:"organization"`
 	JobType               string      `json:"job_type"`
 	Inventory             int         `json:"inventory"`
 	Project               int         `json:"project"`
@@ -460,7 +479,7 @@ type JobLaunch struct {
 	AskInventoryOnLaunch    bool              `json:"ask_inventory_on_launch"`
 	AskCredentialOnLaunch   bool              `json:"ask_credential_on_launch"`
 	AllowSimultaneous       bool              `json:"allow_simultaneous"`
-	Artifacts               map[string]string `json:"artifacts"`
+	Artifacts               JobArtifacts      `json:"artifacts"`
 	ScmRevision             string            `json:"scm_revision"`
 	InstanceGroup           interface{}       `json:"instance_group"`
 	DiffMode                bool              `json:"diff_mode"`
@@ -468,6 +487,9 @@ type JobLaunch struct {
 	VaultCredential         interface{}       `json:"vault_credential"`
 }
 
+// JobArtifacts are the stats set by `set_stats` in a job, which are not only strings.
+type JobArtifacts map[string]interface{}
+
 // Job represents the awx api job.
 type Job struct {
 	ID                      int               `json:"id"`
@@ -519,7 +541,7 @@ type Job struct {
 	AskInventoryOnLaunch    bool              `json:"ask_inventory_on_launch"`
 	AskCredentialOnLaunch   bool              `json:"ask_credential_on_launch"`
 	AllowSimultaneous       bool              `json:"allow_simultaneous"`
-	Artifacts               map[string]string `json:"artifacts"`
+	Artifacts               JobArtifacts      `json:"artifacts"`
 	ScmRevision             string            `json:"scm_revision"`
 	InstanceGroup           int               `json:"instance_group"`
 	DiffMode                bool              `json:"diff_mode"`
@@ -609,6 +631,8 @@ type EventRes struct {
 	Invocation    *EventInvocation `json:"invocation"`
 	StdoutLines   []string         `json:"stdout_lines"`
 	Warnings      []string         `json:"warnings"`
+	// Msg is the message of the module, a string or a list of strings.
+	Msg interface{} `json:"msg"`
 }
 
 // EventData represents the awx api event data.
@@ -629,6 +653,7 @@ type EventData struct {
 	Host         string      `json:"host"`
 	Role         string      `json:"role"`
 	TaskPath     string      `json:"task_path"`
+	IgnoreErrors bool        `json:"ignore_errors"`
 }
 
 // JobEvent represents the awx api job event.
@@ -651,8 +676,8 @@ type JobEvent struct {
 	UUID          string             `json:"uuid"`
 	ParentUUID    string             `json:"parent_uuid"`
 
-	// FIXME: inconsistent value type from tower API, int, null
-	Host interface{} `json:"host"`
+	// Host is null for the events which are not related to a host.
+	Host NullID `json:"host"`
 
 	HostName  string      `json:"host_name"`
 	Parent    interface{} `json:"parent"`
@@ -895,3 +920,78 @@ type ExecutionEnvironment struct {
 	Credential    int       `json:"credential"`
 	Pull          string    `json:"pull"`
 }
+
+// CopyCapability represents the awx api copy endpoint response.
+type CopyCapability struct {
+	CanCopy                 bool     `json:"can_copy"`
+	CanCopyWithoutUserInput bool     `json:"can_copy_without_user_input"`
+	TemplatesUnableToCopy   []string `json:"templates_unable_to_copy"`
+	CredentialsUnableToCopy []string `json:"credentials_unable_to_copy"`
+	InventoriesUnableToCopy []string `json:"inventories_unable_to_copy"`
+}
+
+// WorkflowJob represents the awx api workflow job.
+type WorkflowJob struct {
+	ID                  int       `json:"id"`
+	Type                string    `json:"type"`
+	URL                 string    `json:"url"`
+	Related             *Related  `json:"related"`
+	SummaryFields       *Summary  `json:"summary_fields"`
+	Created             time.Time `json:"created"`
+	Modified            time.Time `json:"modified"`
+	Name                string    `json:"name"`
+	Description         string    `json:"description"`
+	UnifiedJobTemplate  int       `json:"unified_job_template"`
+	WorkflowJobTemplate int       `json:"workflow_job_template"`
+	LaunchType          string    `json:"launch_type"`
+	Status              string    `json:"status"`
+	Failed              bool      `json:"failed"`
+	Started             time.Time `json:"started"`
+	Finished            time.Time `json:"finished"`
+	Elapsed             float64   `json:"elapsed"`
+	JobExplanation      string    `json:"job_explanation"`
+	ResultTraceback     string    `json:"result_traceback"`
+	ExtraVars           string    `json:"extra_vars"`
+	AllowSimultaneous   bool      `json:"allow_simultaneous"`
+	Inventory           int       `json:"inventory"`
+	Limit               string    `json:"limit"`
+	ScmBranch           string    `json:"scm_branch"`
+}
+
+// UnifiedJobSummary represents the awx api summary of a job, a project update or any other unified job.
+type UnifiedJobSummary struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Status      string  `json:"status"`
+	Failed      bool    `json:"failed"`
+	Elapsed     float64 `json:"elapsed"`
+	// Type is the type of the unified job, e.g. `job` or `project_update`.
+	Type string `json:"type"`
+}
+
+// WorkflowJobNodeSummaryFields represents the awx api workflow job node summary fields.
+type WorkflowJobNodeSummaryFields struct {
+	Job                *UnifiedJobSummary  `json:"job"`
+	UnifiedJobTemplate *UnifiedJobTemplate `json:"unified_job_template"`
+}
+
+// WorkflowJobNode represents the awx api workflow job node.
+type WorkflowJobNode struct {
+	ID            int                           `json:"id"`
+	Type          string                        `json:"type"`
+	URL           string                        `json:"url"`
+	Related       *Related                      `json:"related"`
+	SummaryFields *WorkflowJobNodeSummaryFields `json:"summary_fields"`
+	Created       time.Time                     `json:"created"`
+	Modified      time.Time                     `json:"modified"`
+	// Job is null until the node runs, and for the nodes which do not run.
+	Job                NullID `json:"job"`
+	WorkflowJob        int    `json:"workflow_job"`
+	UnifiedJobTemplate int    `json:"unified_job_template"`
+	Identifier         string `json:"identifier"`
+	DoNotRun           bool   `json:"do_not_run"`
+	SuccessNodes       []int  `json:"success_nodes"`
+	FailureNodes       []int  `json:"failure_nodes"`
+	AlwaysNodes        []int  `json:"always_nodes"`
+}
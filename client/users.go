@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // UserService implements awx Users apis.
@@ -13,8 +15,35 @@ type UserService interface {
 	Create(data map[string]interface{}, params map[string]string) (*User, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*User, error)
 	Delete(id int) (*User, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*User, *ResultsList[User], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*User, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*User, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*User, error)
+	DeleteContext(ctx context.Context, id int) (*User, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*User, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[User]
+	ListQuery(ctx context.Context, q *Query) ([]*User, *ResultsList[User], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*User, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[User]
+	CreateWith(ctx context.Context, req CreateRequest[User], params map[string]string) (*User, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[User], params map[string]string) (*User, error)
+	GetByName(name string, scope map[string]string) (*User, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*User, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*User, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*User, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[User]) (*EnsureResult[User], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*User, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 	ListUserRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListUsersEntitlementsResponse, error)
+	ListUserRoleEntitlementsContext(ctx context.Context, id int, params map[string]string) ([]*ApplyRole, *ListUsersEntitlementsResponse, error)
+	ListUserRoleEntitlementsQuery(ctx context.Context, id int, q *Query) ([]*ApplyRole, *ListUsersEntitlementsResponse, error)
 	UpdateUserRoleEntitlement(id int, data map[string]interface{}, params map[string]string) (interface{}, error)
+	UpdateUserRoleEntitlementContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (interface{}, error)
 }
 
 type userServiceHTTP struct {
@@ -35,10 +64,66 @@ type ListUsersEntitlementsResponse struct {
 
 const usersAPIEndpoint = "/api/v2/users/"
 
+// UserCreateRequest holds the fields of a new user, its username and password are required.
+type UserCreateRequest struct {
+	Username        string `json:"username"`
+	Password        string `json:"password"`
+	FirstName       string `json:"first_name,omitempty"`
+	LastName        string `json:"last_name,omitempty"`
+	Email           string `json:"email,omitempty"`
+	IsSuperUser     *bool  `json:"is_superuser,omitempty"`
+	IsSystemAuditor *bool  `json:"is_system_auditor,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *UserCreateRequest) Validate() error {
+	return checkFields(
+		required("username", r.Username != ""),
+		required("password", r.Password != ""),
+	)
+}
+
+func (*UserCreateRequest) createRequest(*User) {}
+
+// UserUpdateRequest changes some fields of a user, e.g. grants the superuser flag or sets a new password.
+type UserUpdateRequest struct {
+	Username        *string `json:"username,omitempty"`
+	Password        *string `json:"password,omitempty"`
+	FirstName       *string `json:"first_name,omitempty"`
+	LastName        *string `json:"last_name,omitempty"`
+	Email           *string `json:"email,omitempty"`
+	IsSuperUser     *bool   `json:"is_superuser,omitempty"`
+	IsSystemAuditor *bool   `json:"is_system_auditor,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *UserUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("username", r.Username),
+		notEmpty("password", r.Password),
+	)
+}
+
+func (*UserUpdateRequest) updateRequest(*User) {}
+
 func (u *userServiceHTTP) ListUserRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
+	return u.ListUserRoleEntitlementsContext(context.Background(), id, params)
+}
+
+// ListUserRoleEntitlementsContext is the context-aware version of ListUserRoleEntitlements.
+func (u *userServiceHTTP) ListUserRoleEntitlementsContext(ctx context.Context, id int, params map[string]string) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
+	return u.listUserRoleEntitlements(ctx, id, mapValues(params))
+}
+
+// ListUserRoleEntitlementsQuery lists the roles of a user matching q.
+func (u *userServiceHTTP) ListUserRoleEntitlementsQuery(ctx context.Context, id int, q *Query) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
+	return u.listUserRoleEntitlements(ctx, id, q.Values())
+}
+
+func (u *userServiceHTTP) listUserRoleEntitlements(ctx context.Context, id int, query url.Values) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
 	result := new(ListUsersEntitlementsResponse)
 	endpoint := fmt.Sprintf("%s%d/roles/", usersAPIEndpoint, id)
-	resp, err := u.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := u.client.Requester.getJSONQueryContext(ctx, endpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -50,6 +135,10 @@ func (u *userServiceHTTP) ListUserRoleEntitlements(id int, params map[string]str
 }
 
 func (u *userServiceHTTP) UpdateUserRoleEntitlement(id int, data map[string]interface{}, params map[string]string) (interface{}, error) {
+	return u.UpdateUserRoleEntitlementContext(context.Background(), id, data, params)
+}
+
+func (u *userServiceHTTP) UpdateUserRoleEntitlementContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (interface{}, error) {
 	result := new(interface{})
 	endpoint := fmt.Sprintf("%s%d/roles/", usersAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -57,7 +146,7 @@ func (u *userServiceHTTP) UpdateUserRoleEntitlement(id int, data map[string]inte
 		return nil, err
 	}
 
-	resp, err := u.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := u.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if err != nil {
 		return nil, err
 	}
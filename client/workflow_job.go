@@ -0,0 +1,86 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// WorkflowJobService implements awx workflow job apis.
+type WorkflowJobService interface {
+	GetWorkflowJob(id int, params map[string]string) (*WorkflowJob, error)
+	GetWorkflowJobContext(ctx context.Context, id int, params map[string]string) (*WorkflowJob, error)
+	ListWorkflowJobNodes(id int, params map[string]string) ([]*WorkflowJobNode, error)
+	ListWorkflowJobNodesContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobNode, error)
+	ListWorkflowJobNodesQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobNode, error)
+	Wait(ctx context.Context, id int, opts *WaitOptions) (*WorkflowJob, error)
+}
+
+type workflowJobServiceHTTP struct {
+	client *Client
+}
+
+const workflowJobAPIEndpoint = "/api/v2/workflow_jobs/"
+
+// GetWorkflowJob shows the details of a workflow job.
+func (wj *workflowJobServiceHTTP) GetWorkflowJob(id int, params map[string]string) (*WorkflowJob, error) {
+	return wj.GetWorkflowJobContext(context.Background(), id, params)
+}
+
+// GetWorkflowJobContext is the context-aware version of GetWorkflowJob.
+func (wj *workflowJobServiceHTTP) GetWorkflowJobContext(ctx context.Context, id int, params map[string]string) (*WorkflowJob, error) {
+	result := new(WorkflowJob)
+	endpoint := fmt.Sprintf("%s%d/", workflowJobAPIEndpoint, id)
+	resp, err := wj.client.Requester.GetJSONContext(ctx, endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListWorkflowJobNodes lists every node of a workflow job, the job each node ran included.
+func (wj *workflowJobServiceHTTP) ListWorkflowJobNodes(id int, params map[string]string) ([]*WorkflowJobNode, error) {
+	return wj.ListWorkflowJobNodesContext(context.Background(), id, params)
+}
+
+// ListWorkflowJobNodesContext is the context-aware version of ListWorkflowJobNodes.
+func (wj *workflowJobServiceHTTP) ListWorkflowJobNodesContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobNode, error) {
+	return wj.listWorkflowJobNodes(ctx, id, mapValues(params))
+}
+
+// ListWorkflowJobNodesQuery lists every node of a workflow job matching q.
+func (wj *workflowJobServiceHTTP) ListWorkflowJobNodesQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobNode, error) {
+	return wj.listWorkflowJobNodes(ctx, id, q.Values())
+}
+
+func (wj *workflowJobServiceHTTP) listWorkflowJobNodes(ctx context.Context, id int, query url.Values) ([]*WorkflowJobNode, error) {
+	endpoint := fmt.Sprintf("%s%d/workflow_nodes/", workflowJobAPIEndpoint, id)
+	return listAll[WorkflowJobNode](ctx, wj.client, endpoint, query)
+}
+
+// Wait polls the workflow job until it finishes and returns it, see JobService.Wait.
+func (wj *workflowJobServiceHTTP) Wait(ctx context.Context, id int, opts *WaitOptions) (*WorkflowJob, error) {
+	workflowJob, err := waitUntilFinished(ctx, id, opts, func(ctx context.Context) (*WorkflowJob, string, error) {
+		workflowJob, err := wj.GetWorkflowJobContext(ctx, id, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		return workflowJob, workflowJob.Status, nil
+	})
+	if err != nil || workflowJob.Status == JobStatusSuccessful {
+		return workflowJob, err
+	}
+
+	return workflowJob, &JobFailedError{
+		ID:              id,
+		Type:            JobTypeWorkflowJob,
+		Status:          workflowJob.Status,
+		JobExplanation:  workflowJob.JobExplanation,
+		ResultTraceback: workflowJob.ResultTraceback,
+	}
+}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -13,7 +14,41 @@ type WorkflowJobTemplateService interface {
 	Create(data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error)
 	Delete(id int) (*WorkflowJobTemplate, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*WorkflowJobTemplate, *ResultsList[WorkflowJobTemplate], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*WorkflowJobTemplate, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error)
+	DeleteContext(ctx context.Context, id int) (*WorkflowJobTemplate, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*WorkflowJobTemplate, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[WorkflowJobTemplate]
+	ListQuery(ctx context.Context, q *Query) ([]*WorkflowJobTemplate, *ResultsList[WorkflowJobTemplate], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*WorkflowJobTemplate, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[WorkflowJobTemplate]
+	CreateWith(ctx context.Context, req CreateRequest[WorkflowJobTemplate], params map[string]string) (*WorkflowJobTemplate, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[WorkflowJobTemplate], params map[string]string) (*WorkflowJobTemplate, error)
+	GetByName(name string, scope map[string]string) (*WorkflowJobTemplate, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*WorkflowJobTemplate, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*WorkflowJobTemplate, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*WorkflowJobTemplate, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[WorkflowJobTemplate]) (*EnsureResult[WorkflowJobTemplate], error)
+	Copy(id int, newName string) (*WorkflowJobTemplate, error)
+	CopyContext(ctx context.Context, id int, newName string) (*WorkflowJobTemplate, error)
+	CanCopy(id int) (*CopyCapability, error)
+	CanCopyContext(ctx context.Context, id int) (*CopyCapability, error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*WorkflowJobTemplate, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 	LaunchWorkflow(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error)
+	LaunchWorkflowContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error)
+	LaunchAndWait(ctx context.Context, id int, data map[string]interface{}, opts *WaitOptions) (*LaunchResult, error)
+	GetLaunchRequirements(id int) (*LaunchRequirements, error)
+	GetLaunchRequirementsContext(ctx context.Context, id int) (*LaunchRequirements, error)
+	ValidateLaunch(id int, launchData map[string]interface{}) (*LaunchValidation, error)
+	ValidateLaunchContext(ctx context.Context, id int, launchData map[string]interface{}) (*LaunchValidation, error)
 }
 
 type workflowJobTemplateServiceHTTP struct {
@@ -29,8 +64,72 @@ type ListWorkflowJobTemplatesResponse struct {
 
 const workflowJobTemplateAPIEndpoint = "/api/v2/workflow_job_templates/"
 
+// WorkflowJobTemplateCreateRequest holds the fields of a new workflow job template, the nodes
+// are created afterwards.
+type WorkflowJobTemplateCreateRequest struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description,omitempty"`
+	Organization         int    `json:"organization,omitempty"`
+	ExtraVars            string `json:"extra_vars,omitempty"`
+	SurveyEnabled        *bool  `json:"survey_enabled,omitempty"`
+	AllowSimultaneous    *bool  `json:"allow_simultaneous,omitempty"`
+	Inventory            int    `json:"inventory,omitempty"`
+	Limit                string `json:"limit,omitempty"`
+	ScmBranch            string `json:"scm_branch,omitempty"`
+	AskVariablesOnLaunch *bool  `json:"ask_variables_on_launch,omitempty"`
+	AskInventoryOnLaunch *bool  `json:"ask_inventory_on_launch,omitempty"`
+	AskScmBranchOnLaunch *bool  `json:"ask_scm_branch_on_launch,omitempty"`
+	AskLimitOnLaunch     *bool  `json:"ask_limit_on_launch,omitempty"`
+	WebhookService       string `json:"webhook_service,omitempty"`
+	WebhookCredential    int    `json:"webhook_credential,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *WorkflowJobTemplateCreateRequest) Validate() error {
+	return checkFields(
+		required("name", r.Name != ""),
+		oneOf("webhook_service", r.WebhookService, "github", "gitlab"),
+	)
+}
+
+func (*WorkflowJobTemplateCreateRequest) createRequest(*WorkflowJobTemplate) {}
+
+// WorkflowJobTemplateUpdateRequest changes some fields of a workflow job template, the nil ones are not sent.
+type WorkflowJobTemplateUpdateRequest struct {
+	Name                 *string `json:"name,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	Organization         *int    `json:"organization,omitempty"`
+	ExtraVars            *string `json:"extra_vars,omitempty"`
+	SurveyEnabled        *bool   `json:"survey_enabled,omitempty"`
+	AllowSimultaneous    *bool   `json:"allow_simultaneous,omitempty"`
+	Inventory            *int    `json:"inventory,omitempty"`
+	Limit                *string `json:"limit,omitempty"`
+	ScmBranch            *string `json:"scm_branch,omitempty"`
+	AskVariablesOnLaunch *bool   `json:"ask_variables_on_launch,omitempty"`
+	AskInventoryOnLaunch *bool   `json:"ask_inventory_on_launch,omitempty"`
+	AskScmBranchOnLaunch *bool   `json:"ask_scm_branch_on_launch,omitempty"`
+	AskLimitOnLaunch     *bool   `json:"ask_limit_on_launch,omitempty"`
+	WebhookService       *string `json:"webhook_service,omitempty"`
+	WebhookCredential    *int    `json:"webhook_credential,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *WorkflowJobTemplateUpdateRequest) Validate() error {
+	return checkFields(
+		notEmpty("name", r.Name),
+		oneOf("webhook_service", deref(r.WebhookService), "github", "gitlab"),
+	)
+}
+
+func (*WorkflowJobTemplateUpdateRequest) updateRequest(*WorkflowJobTemplate) {}
+
 // Launch a job with the workflow job template.
 func (jt *workflowJobTemplateServiceHTTP) LaunchWorkflow(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+	return jt.LaunchWorkflowContext(context.Background(), id, data, params)
+}
+
+// LaunchWorkflowContext is the context-aware version of LaunchWorkflow.
+func (jt *workflowJobTemplateServiceHTTP) LaunchWorkflowContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/launch/", workflowJobTemplateAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -38,7 +137,7 @@ func (jt *workflowJobTemplateServiceHTTP) LaunchWorkflow(id int, data map[string
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PostJSONContext(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,7 @@
 package awx
 
+import "context"
+
 // WorkflowJobTemplateNodeService implements awx job template node apis.
 type WorkflowJobTemplateNodeService interface {
 	List(params map[string]string) ([]*WorkflowJobTemplateNode, *ResultsList[WorkflowJobTemplateNode], error)
@@ -7,6 +9,30 @@ type WorkflowJobTemplateNodeService interface {
 	Create(data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
 	Update(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
 	Delete(id int) (*WorkflowJobTemplateNode, error)
+	ListContext(ctx context.Context, params map[string]string) ([]*WorkflowJobTemplateNode, *ResultsList[WorkflowJobTemplateNode], error)
+	GetByIDContext(ctx context.Context, id int, params map[string]string) (*WorkflowJobTemplateNode, error)
+	CreateContext(ctx context.Context, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
+	UpdateContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
+	DeleteContext(ctx context.Context, id int) (*WorkflowJobTemplateNode, error)
+	ListAll(ctx context.Context, params map[string]string) ([]*WorkflowJobTemplateNode, error)
+	Iterate(ctx context.Context, params map[string]string) *Pager[WorkflowJobTemplateNode]
+	ListQuery(ctx context.Context, q *Query) ([]*WorkflowJobTemplateNode, *ResultsList[WorkflowJobTemplateNode], error)
+	ListAllQuery(ctx context.Context, q *Query) ([]*WorkflowJobTemplateNode, error)
+	IterateQuery(ctx context.Context, q *Query) *Pager[WorkflowJobTemplateNode]
+	CreateWith(ctx context.Context, req CreateRequest[WorkflowJobTemplateNode], params map[string]string) (*WorkflowJobTemplateNode, error)
+	UpdateWith(ctx context.Context, id int, req UpdateRequest[WorkflowJobTemplateNode], params map[string]string) (*WorkflowJobTemplateNode, error)
+	GetByName(name string, scope map[string]string) (*WorkflowJobTemplateNode, error)
+	GetByNameContext(ctx context.Context, name string, scope map[string]string) (*WorkflowJobTemplateNode, error)
+	GetByNamedURL(namedURL string, params map[string]string) (*WorkflowJobTemplateNode, error)
+	GetByNamedURLContext(ctx context.Context, namedURL string, params map[string]string) (*WorkflowJobTemplateNode, error)
+	Ensure(ctx context.Context, identity map[string]string, desired CreateRequest[WorkflowJobTemplateNode]) (*EnsureResult[WorkflowJobTemplateNode], error)
+	ListSummaries(ctx context.Context, params map[string]string) ([]*ObjectSummary, error)
+	GetSummary(ctx context.Context, id int) (*ObjectSummary, error)
+	ListIDs(ctx context.Context, params map[string]string) ([]int, error)
+	ListNames(ctx context.Context, params map[string]string) ([]string, error)
+	GetMany(ctx context.Context, ids []int, opts ...BulkOption) ([]*WorkflowJobTemplateNode, error)
+	DeleteMany(ctx context.Context, ids []int, opts ...BulkOption) ([]int, error)
+	DeleteWhere(ctx context.Context, q *Query, opts ...BulkOption) ([]int, error)
 }
 
 type workflowJobTemplateNodeServiceHTTP struct {
@@ -21,3 +47,60 @@ type ListWorkflowJobTemplateNodesResponse struct {
 }
 
 const workflowJobTemplateNodeAPIEndpoint = "/api/v2/workflow_job_template_nodes/"
+
+// WorkflowJobTemplateNodeCreateRequest adds a node running the unified job template to a
+// workflow job template, with the prompts it launches it with.
+type WorkflowJobTemplateNodeCreateRequest struct {
+	WorkflowJobTemplate    int                    `json:"workflow_job_template"`
+	UnifiedJobTemplate     int                    `json:"unified_job_template"`
+	Identifier             string                 `json:"identifier,omitempty"`
+	ExtraData              map[string]interface{} `json:"extra_data,omitempty"`
+	Inventory              int                    `json:"inventory,omitempty"`
+	ScmBranch              string                 `json:"scm_branch,omitempty"`
+	JobType                string                 `json:"job_type,omitempty"`
+	JobTags                string                 `json:"job_tags,omitempty"`
+	SkipTags               string                 `json:"skip_tags,omitempty"`
+	Limit                  string                 `json:"limit,omitempty"`
+	DiffMode               *bool                  `json:"diff_mode,omitempty"`
+	Verbosity              *int                   `json:"verbosity,omitempty"`
+	AllParentsMustConverge *bool                  `json:"all_parents_must_converge,omitempty"`
+}
+
+// Validate implements CreateRequest.
+func (r *WorkflowJobTemplateNodeCreateRequest) Validate() error {
+	return checkFields(
+		required("workflow_job_template", r.WorkflowJobTemplate != 0),
+		required("unified_job_template", r.UnifiedJobTemplate != 0),
+		oneOf("job_type", r.JobType, "run", "check"),
+		between("verbosity", r.Verbosity, 0, 5),
+	)
+}
+
+func (*WorkflowJobTemplateNodeCreateRequest) createRequest(*WorkflowJobTemplateNode) {}
+
+// WorkflowJobTemplateNodeUpdateRequest changes the job template or the prompts of a workflow node.
+type WorkflowJobTemplateNodeUpdateRequest struct {
+	WorkflowJobTemplate    *int                   `json:"workflow_job_template,omitempty"`
+	UnifiedJobTemplate     *int                   `json:"unified_job_template,omitempty"`
+	Identifier             *string                `json:"identifier,omitempty"`
+	ExtraData              map[string]interface{} `json:"extra_data,omitempty"`
+	Inventory              *int                   `json:"inventory,omitempty"`
+	ScmBranch              *string                `json:"scm_branch,omitempty"`
+	JobType                *string                `json:"job_type,omitempty"`
+	JobTags                *string                `json:"job_tags,omitempty"`
+	SkipTags               *string                `json:"skip_tags,omitempty"`
+	Limit                  *string                `json:"limit,omitempty"`
+	DiffMode               *bool                  `json:"diff_mode,omitempty"`
+	Verbosity              *int                   `json:"verbosity,omitempty"`
+	AllParentsMustConverge *bool                  `json:"all_parents_must_converge,omitempty"`
+}
+
+// Validate implements UpdateRequest.
+func (r *WorkflowJobTemplateNodeUpdateRequest) Validate() error {
+	return checkFields(
+		oneOf("job_type", deref(r.JobType), "run", "check"),
+		between("verbosity", r.Verbosity, 0, 5),
+	)
+}
+
+func (*WorkflowJobTemplateNodeUpdateRequest) updateRequest(*WorkflowJobTemplateNode) {}
@@ -2,19 +2,30 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 )
 
 // WorkflowJobTemplateNodeStepService implements awx job template nodes apis.
 type WorkflowJobTemplateNodeStepService interface {
 	ListWorkflowJobTemplateSuccessNodeSteps(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	ListWorkflowJobTemplateSuccessNodeStepsContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	ListWorkflowJobTemplateSuccessNodeStepsQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
 	CreateWorkflowJobTemplateSuccessNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
+	CreateWorkflowJobTemplateSuccessNodeStepContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
 	ListWorkflowJobTemplateFailureNodeSteps(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	ListWorkflowJobTemplateFailureNodeStepsContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	ListWorkflowJobTemplateFailureNodeStepsQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
 	CreateWorkflowJobTemplateFailureNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
+	CreateWorkflowJobTemplateFailureNodeStepContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
 	ListWorkflowJobTemplateAlwaysNodeSteps(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	ListWorkflowJobTemplateAlwaysNodeStepsContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	ListWorkflowJobTemplateAlwaysNodeStepsQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
 	CreateWorkflowJobTemplateAlwaysNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
+	CreateWorkflowJobTemplateAlwaysNodeStepContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error)
 }
 
 type workflowJobTemplateNodeStepServiceHTTP struct {
@@ -22,38 +33,78 @@ type workflowJobTemplateNodeStepServiceHTTP struct {
 }
 
 func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateSuccessNodeSteps(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
-	return jt.listWorkflowJobTemplateNodeSteps(id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/success_nodes/"), params)
+	return jt.ListWorkflowJobTemplateSuccessNodeStepsContext(context.Background(), id, params)
 }
+
+func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateSuccessNodeStepsContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+	return jt.listWorkflowJobTemplateNodeSteps(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/success_nodes/"), mapValues(params))
+}
+
+// ListWorkflowJobTemplateSuccessNodeStepsQuery lists the success nodes of a workflow job template node matching q.
+func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateSuccessNodeStepsQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+	return jt.listWorkflowJobTemplateNodeSteps(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/success_nodes/"), q.Values())
+}
+
 func (jt *workflowJobTemplateNodeStepServiceHTTP) CreateWorkflowJobTemplateSuccessNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
-	return jt.createWorkflowJobTemplateNodeStep(id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/success_nodes/"), data, params)
+	return jt.CreateWorkflowJobTemplateSuccessNodeStepContext(context.Background(), id, data, params)
+}
+
+func (jt *workflowJobTemplateNodeStepServiceHTTP) CreateWorkflowJobTemplateSuccessNodeStepContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+	return jt.createWorkflowJobTemplateNodeStep(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/success_nodes/"), data, params)
 
 }
 func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateFailureNodeSteps(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
-	return jt.listWorkflowJobTemplateNodeSteps(id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/failure_nodes/"), params)
+	return jt.ListWorkflowJobTemplateFailureNodeStepsContext(context.Background(), id, params)
+}
+
+func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateFailureNodeStepsContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+	return jt.listWorkflowJobTemplateNodeSteps(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/failure_nodes/"), mapValues(params))
+}
 
+// ListWorkflowJobTemplateFailureNodeStepsQuery lists the failure nodes of a workflow job template node matching q.
+func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateFailureNodeStepsQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+	return jt.listWorkflowJobTemplateNodeSteps(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/failure_nodes/"), q.Values())
 }
+
 func (jt *workflowJobTemplateNodeStepServiceHTTP) CreateWorkflowJobTemplateFailureNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
-	return jt.createWorkflowJobTemplateNodeStep(id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/failure_nodes/"), data, params)
+	return jt.CreateWorkflowJobTemplateFailureNodeStepContext(context.Background(), id, data, params)
+}
+
+func (jt *workflowJobTemplateNodeStepServiceHTTP) CreateWorkflowJobTemplateFailureNodeStepContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+	return jt.createWorkflowJobTemplateNodeStep(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/failure_nodes/"), data, params)
 
 }
 func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateAlwaysNodeSteps(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
-	return jt.listWorkflowJobTemplateNodeSteps(id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/always_nodes/"), params)
+	return jt.ListWorkflowJobTemplateAlwaysNodeStepsContext(context.Background(), id, params)
+}
+
+func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateAlwaysNodeStepsContext(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+	return jt.listWorkflowJobTemplateNodeSteps(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/always_nodes/"), mapValues(params))
+}
 
+// ListWorkflowJobTemplateAlwaysNodeStepsQuery lists the always nodes of a workflow job template node matching q.
+func (jt *workflowJobTemplateNodeStepServiceHTTP) ListWorkflowJobTemplateAlwaysNodeStepsQuery(ctx context.Context, id int, q *Query) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+	return jt.listWorkflowJobTemplateNodeSteps(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/always_nodes/"), q.Values())
 }
+
 func (jt *workflowJobTemplateNodeStepServiceHTTP) CreateWorkflowJobTemplateAlwaysNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
-	return jt.createWorkflowJobTemplateNodeStep(id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/always_nodes/"), data, params)
+	return jt.CreateWorkflowJobTemplateAlwaysNodeStepContext(context.Background(), id, data, params)
+}
+
+func (jt *workflowJobTemplateNodeStepServiceHTTP) CreateWorkflowJobTemplateAlwaysNodeStepContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+	return jt.createWorkflowJobTemplateNodeStep(ctx, id, fmt.Sprintf("%s%s", workflowJobTemplateNodeAPIEndpoint, "%d/always_nodes/"), data, params)
 
 }
 
 // ListWorkflowJobTemplateNodeSteps shows a list of job templates nodes.
-func (jt *workflowJobTemplateNodeStepServiceHTTP) listWorkflowJobTemplateNodeSteps(id int, endpoint string, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+func (jt *workflowJobTemplateNodeStepServiceHTTP) listWorkflowJobTemplateNodeSteps(ctx context.Context, id int, endpoint string, query url.Values) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
 	workflowJobTemplateNodesActionEndpoint := fmt.Sprintf(endpoint, id)
-	return fetchWorkflowJobTemplateNode(jt.client, params, workflowJobTemplateNodesActionEndpoint)
+	return fetchWorkflowJobTemplateNode(ctx, jt.client, query, workflowJobTemplateNodesActionEndpoint)
 }
 
-func fetchWorkflowJobTemplateNode(client *Client, params map[string]string, workflowJobTemplateNodesActionEndpoint string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+func fetchWorkflowJobTemplateNode(ctx context.Context, client *Client, query url.Values, workflowJobTemplateNodesActionEndpoint string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
 	result := new(ListWorkflowJobTemplateNodesResponse)
-	resp, err := client.Requester.GetJSON(workflowJobTemplateNodesActionEndpoint, result, params)
+	resp, err := client.Requester.getJSONQueryContext(ctx, workflowJobTemplateNodesActionEndpoint, result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -65,19 +116,18 @@ func fetchWorkflowJobTemplateNode(client *Client, params map[string]string, work
 	return result.Results, result, nil
 }
 
-func createWorkflowJobTemplateNode(client *Client, data map[string]interface{}, params map[string]string, workflowJobTemplateNodesActionEndpoint string) (*WorkflowJobTemplateNode, error) {
+func createWorkflowJobTemplateNode(ctx context.Context, client *Client, data map[string]interface{}, params map[string]string, workflowJobTemplateNodesActionEndpoint string) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	mandatoryFields := []string{"unified_job_template", "identifier"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
-		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
-		return nil, err
+		return nil, newMandatoryFieldsError(validate)
 	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Requester.PostJSON(workflowJobTemplateNodesActionEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := client.Requester.PostJSONContext(ctx, workflowJobTemplateNodesActionEndpoint, bytes.NewReader(payload), result, params)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +139,7 @@ func createWorkflowJobTemplateNode(client *Client, data map[string]interface{},
 }
 
 // CreateWorkflowJobTemplateNodeStep will be created a template node for a existing node
-func (jt *workflowJobTemplateNodeStepServiceHTTP) createWorkflowJobTemplateNodeStep(id int, endpoint string, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *workflowJobTemplateNodeStepServiceHTTP) createWorkflowJobTemplateNodeStep(ctx context.Context, id int, endpoint string, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
 	workflowJobTemplateNodesActionEndpoint := fmt.Sprintf(endpoint, id)
-	return createWorkflowJobTemplateNode(jt.client, data, params, workflowJobTemplateNodesActionEndpoint)
+	return createWorkflowJobTemplateNode(ctx, jt.client, data, params, workflowJobTemplateNodesActionEndpoint)
 }
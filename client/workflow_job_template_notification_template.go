@@ -1,55 +1,39 @@
 package awx
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-)
-
-const workflowJobTemplateNotificationTemplatesAPIEndpoint = "/api/v2/workflow_job_templates/%d/notification_templates_%s/"
+import "context"
 
 // WorkflowJobTemplateNotificationTemplatesService implements awx job template nodes apis.
 type WorkflowJobTemplateNotificationTemplateService interface {
 	AssociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	AssociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	AssociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	AssociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesApprovalsContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	DisassociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	DisassociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	DisassociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 	DisassociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
 }
 
 type workflowJobTemplateNotificationTemplateServiceHTTP struct {
 	client *Client
 }
 
-func (s *workflowJobTemplateNotificationTemplateServiceHTTP) associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) associateWorkflowJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
 		"id": notificationTemplateID,
 	}
-
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-
-	endpoint := fmt.Sprintf(workflowJobTemplateNotificationTemplatesAPIEndpoint, jobTemplateID, typ)
-
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := s.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	relation := notificationTemplatesRelationPrefix + typ
+	if err := NewAssociation[WorkflowJobTemplate, NotificationTemplate](s.client, relation).send(ctx, jobTemplateID, data, result); err != nil {
 		return nil, err
 	}
 
@@ -58,50 +42,53 @@ func (s *workflowJobTemplateNotificationTemplateServiceHTTP) associateWorkflowJo
 
 // AssociateWorkflowJobTemplateNotificationTemplatesError will associate an error notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+	return s.AssociateWorkflowJobTemplateNotificationTemplatesErrorContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesErrorContext is the context-aware version of AssociateWorkflowJobTemplateNotificationTemplatesError.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // AssociateWorkflowJobTemplateNotificationTemplatesSuccess will associate a success notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+	return s.AssociateWorkflowJobTemplateNotificationTemplatesSuccessContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesSuccessContext is the context-aware version of AssociateWorkflowJobTemplateNotificationTemplatesSuccess.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // AssociateWorkflowJobTemplateNotificationTemplatesStarted will associate a started notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+	return s.AssociateWorkflowJobTemplateNotificationTemplatesStartedContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesStartedContext is the context-aware version of AssociateWorkflowJobTemplateNotificationTemplatesStarted.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
 
 // AssociateWorkflowJobTemplateNotificationTemplatesApprovals will associate an approval notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "approvals")
+	return s.AssociateWorkflowJobTemplateNotificationTemplatesApprovalsContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesApprovalsContext is the context-aware version of AssociateWorkflowJobTemplateNotificationTemplatesApprovals.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) AssociateWorkflowJobTemplateNotificationTemplatesApprovalsContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "approvals")
 }
 
-func (s *workflowJobTemplateNotificationTemplateServiceHTTP) disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
 		"id":           notificationTemplateID,
 		"disassociate": true,
 	}
-	mandatoryFields := []string{"id"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-
-	endpoint := fmt.Sprintf(workflowJobTemplateNotificationTemplatesAPIEndpoint, jobTemplateID, typ)
-
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := s.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
+	relation := notificationTemplatesRelationPrefix + typ
+	if err := NewAssociation[WorkflowJobTemplate, NotificationTemplate](s.client, relation).send(ctx, jobTemplateID, data, result); err != nil {
 		return nil, err
 	}
 
@@ -110,20 +97,40 @@ func (s *workflowJobTemplateNotificationTemplateServiceHTTP) disassociateWorkflo
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesError will disassociate an error notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+	return s.DisassociateWorkflowJobTemplateNotificationTemplatesErrorContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesErrorContext is the context-aware version of DisassociateWorkflowJobTemplateNotificationTemplatesError.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesErrorContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesSuccess will disassociate a success notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+	return s.DisassociateWorkflowJobTemplateNotificationTemplatesSuccessContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesSuccessContext is the context-aware version of DisassociateWorkflowJobTemplateNotificationTemplatesSuccess.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesSuccessContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesStarted will disassociate a started notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+	return s.DisassociateWorkflowJobTemplateNotificationTemplatesStartedContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesStartedContext is the context-aware version of DisassociateWorkflowJobTemplateNotificationTemplatesStarted.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesStartedContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesApprovals will disassociate an approval notification_template for a job_template
 func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "approvals")
+	return s.DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsContext(context.Background(), jobTemplateID, notificationTemplateID)
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsContext is the context-aware version of DisassociateWorkflowJobTemplateNotificationTemplatesApprovals.
+func (s *workflowJobTemplateNotificationTemplateServiceHTTP) DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsContext(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "approvals")
 }
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 const workflowJobTemplateSchedulesAPIEndpoint = "/api/v2/workflow_job_templates/%d/schedules/"
@@ -11,7 +13,10 @@ const workflowJobTemplateSchedulesAPIEndpoint = "/api/v2/workflow_job_templates/
 // WorkflowJobTemplateScheduleService implements awx job template nodes apis.
 type WorkflowJobTemplateScheduleService interface {
 	ListWorkflowJobTemplateSchedules(id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error)
+	ListWorkflowJobTemplateSchedulesContext(ctx context.Context, id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error)
+	ListWorkflowJobTemplateSchedulesQuery(ctx context.Context, id int, q *Query) ([]*Schedule, *ListSchedulesResponse, error)
 	CreateWorkflowJobTemplateSchedule(id int, data map[string]interface{}, params map[string]string) (*Schedule, error)
+	CreateWorkflowJobTemplateScheduleContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Schedule, error)
 }
 
 type workflowJobTemplateScheduleServiceHTTP struct {
@@ -20,10 +25,24 @@ type workflowJobTemplateScheduleServiceHTTP struct {
 
 // ListWorkflowJobTemplateSchedules shows a list of schedules for a given workflow_job_template
 func (jt *workflowJobTemplateScheduleServiceHTTP) ListWorkflowJobTemplateSchedules(id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error) {
+	return jt.ListWorkflowJobTemplateSchedulesContext(context.Background(), id, params)
+}
+
+// ListWorkflowJobTemplateSchedulesContext is the context-aware version of ListWorkflowJobTemplateSchedules.
+func (jt *workflowJobTemplateScheduleServiceHTTP) ListWorkflowJobTemplateSchedulesContext(ctx context.Context, id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error) {
+	return jt.listWorkflowJobTemplateSchedules(ctx, id, mapValues(params))
+}
+
+// ListWorkflowJobTemplateSchedulesQuery lists the schedules of a workflow job template matching q.
+func (jt *workflowJobTemplateScheduleServiceHTTP) ListWorkflowJobTemplateSchedulesQuery(ctx context.Context, id int, q *Query) ([]*Schedule, *ListSchedulesResponse, error) {
+	return jt.listWorkflowJobTemplateSchedules(ctx, id, q.Values())
+}
+
+func (jt *workflowJobTemplateScheduleServiceHTTP) listWorkflowJobTemplateSchedules(ctx context.Context, id int, query url.Values) ([]*Schedule, *ListSchedulesResponse, error) {
 	result := new(ListSchedulesResponse)
-	resp, err := jt.client.Requester.GetJSON(
+	resp, err := jt.client.Requester.getJSONQueryContext(ctx,
 		fmt.Sprintf(workflowJobTemplateSchedulesAPIEndpoint, id),
-		result, params)
+		result, query)
 	if err != nil {
 		return nil, result, err
 	}
@@ -37,11 +56,15 @@ func (jt *workflowJobTemplateScheduleServiceHTTP) ListWorkflowJobTemplateSchedul
 
 // CreateWorkflowJobTemplateSchedule will create a schedule for an existing workflow_job_template
 func (jt *workflowJobTemplateScheduleServiceHTTP) CreateWorkflowJobTemplateSchedule(id int, data map[string]interface{}, params map[string]string) (*Schedule, error) {
+	return jt.CreateWorkflowJobTemplateScheduleContext(context.Background(), id, data, params)
+}
+
+// CreateWorkflowJobTemplateScheduleContext is the context-aware version of CreateWorkflowJobTemplateSchedule.
+func (jt *workflowJobTemplateScheduleServiceHTTP) CreateWorkflowJobTemplateScheduleContext(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Schedule, error) {
 	mandatoryFields := []string{"name", "rrule"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
+		return nil, newMandatoryFieldsError(validate)
 	}
 
 	result := new(Schedule)
@@ -50,7 +73,7 @@ func (jt *workflowJobTemplateScheduleServiceHTTP) CreateWorkflowJobTemplateSched
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PostJSON(
+	resp, err := jt.client.Requester.PostJSONContext(ctx,
 		fmt.Sprintf(workflowJobTemplateSchedulesAPIEndpoint, id),
 		bytes.NewReader(payload), result, params)
 	if err != nil {